@@ -0,0 +1,297 @@
+package timehelper
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// Clock is a source of current time.
+// It allows to replace time.Now in code which uses SinceClock and SinceExtendedClock, for example with FakeClock in tests.
+type Clock interface {
+	Now() time.Time
+}
+
+// RealClock is a Clock which returns current time by time.Now.
+var RealClock Clock = realClock{}
+
+type realClock struct{}
+
+// Now returns time.Now().
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+// SinceClock returns elapsed time since given timestamp up to c.Now() as Interval (=Diff(t, c.Now())).
+// If c is nil RealClock is used. Result always have months & days parts set to zero.
+func SinceClock(c Clock, t time.Time) Interval {
+	if c == nil {
+		c = RealClock
+	}
+	return Diff(t, c.Now())
+}
+
+// SinceExtendedClock returns elapsed time since given timestamp up to c.Now() as Interval (=DiffExtended(t, c.Now())).
+// If c is nil RealClock is used. Result may have non-zero months & days parts.
+func SinceExtendedClock(c Clock, t time.Time) Interval {
+	if c == nil {
+		c = RealClock
+	}
+	return DiffExtended(t, c.Now().In(t.Location()))
+}
+
+// FakeClock is a Clock which time changes only by Set and Advance.
+// It also provides timers and tickers (After, NewTimer, AfterFunc and NewTicker) which are fired when FakeClock reaches their time.
+// Durations of timers and periods of tickers are Intervals applied as Interval.AddTo,
+// so ticker with period "1 mon" started at January 31 ticks at March 2 (or 3), March 31, May 1 and so on.
+// It is safe for concurrent use.
+type FakeClock struct {
+	mutex  sync.Mutex
+	now    time.Time
+	timers []*fakeTimer // Active timers and tickers.
+	seq    uint64       // Number of created timers, used to fire timers with the same time in order of creation.
+}
+
+// fakeTimer is a timer or ticker of FakeClock.
+type fakeTimer struct {
+	clock  *FakeClock
+	c      chan time.Time
+	f      func()
+	when   time.Time
+	seq    uint64
+	start  time.Time // Time ticker was started (or reset) at.
+	period Interval  // Period of ticker (zero for timers).
+	n      int64     // Number of periods from start to when.
+}
+
+// NewFakeClock returns FakeClock with current time t.
+func NewFakeClock(t time.Time) *FakeClock {
+	return &FakeClock{now: t}
+}
+
+// Now returns current time of c.
+func (c *FakeClock) Now() time.Time {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.now
+}
+
+// Set sets current time of c to t. All timers and tickers due up to t are fired (see Advance).
+func (c *FakeClock) Set(t time.Time) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.advanceTo(t)
+}
+
+// Advance moves current time of c by interval i (as i.AddTo) and returns new current time.
+// So advancing by "1 mon" from January 31 leads to March 2 or 3 and advancing by "1 day" keeps wall clock across DST change.
+// Timers and tickers due up to new current time are fired in order of their time (and in order of creation for the same time),
+// current time is set to time of each timer while it fires.
+// Ticker fires for each passed tick, but as for time.Ticker ticks are dropped if nobody reads them from channel.
+// Functions of AfterFunc are called synchronously by goroutine calling Advance.
+func (c *FakeClock) Advance(i Interval) time.Time {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.advanceTo(i.AddTo(c.now))
+	return c.now
+}
+
+// advanceTo fires timers due up to t and sets current time to t. Mutex should be locked.
+func (c *FakeClock) advanceTo(t time.Time) {
+	if t.Before(c.now) {
+		c.now = t
+	}
+	for {
+		var next *fakeTimer
+		for _, timer := range c.timers {
+			if !timer.when.After(t) && (next == nil || timer.when.Before(next.when) || (timer.when.Equal(next.when) && timer.seq < next.seq)) {
+				next = timer
+			}
+		}
+		if next == nil {
+			break
+		}
+		if next.when.After(c.now) {
+			c.now = next.when
+		}
+		c.fire(next)
+	}
+	if t.After(c.now) {
+		c.now = t
+	}
+}
+
+// fire fires timer (which should be active) and reschedules it if it is ticker. Mutex should be locked, but it is unlocked while AfterFunc function is called.
+func (c *FakeClock) fire(timer *fakeTimer) {
+	if timer.f != nil {
+		c.remove(timer)
+		c.mutex.Unlock()
+		defer c.mutex.Lock()
+		timer.f()
+		return
+	}
+
+	select {
+	case timer.c <- timer.when:
+	default:
+	}
+
+	if timer.period == (Interval{}) {
+		c.remove(timer)
+		return
+	}
+	// Ticks are calculated from start time to avoid drift: Jan 31 + 2 mons is Mar 31, but Jan 31 + 1 mon + 1 mon is Apr 2 (or 3).
+	timer.n++
+	if period, err := timer.period.MulChecked(timer.n); err == nil {
+		timer.when = period.AddTo(timer.start)
+	} else {
+		c.remove(timer)
+	}
+}
+
+// add schedules timer to fire after d and fires it immediately if it is already due. Mutex should be locked.
+func (c *FakeClock) add(timer *fakeTimer, d Interval) {
+	c.seq++
+	timer.seq = c.seq
+	timer.start, timer.n = c.now, 0
+	if timer.period != (Interval{}) {
+		d = timer.period
+		timer.n = 1
+	}
+	timer.when = d.AddTo(c.now)
+	c.timers = append(c.timers, timer)
+	c.advanceTo(c.now)
+}
+
+// remove removes timer from active timers and reports if it was active. Mutex should be locked.
+func (c *FakeClock) remove(timer *fakeTimer) bool {
+	for j, v := range c.timers {
+		if v == timer {
+			c.timers = append(c.timers[:j], c.timers[j+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// drain removes not received value from channel of timer.
+func (timer *fakeTimer) drain() {
+	select {
+	case <-timer.c:
+	default:
+	}
+}
+
+// FakeTimer is a single event timer of FakeClock. It is similar to time.Timer.
+type FakeTimer struct {
+	C <-chan time.Time
+	t *fakeTimer
+}
+
+// NewTimer creates FakeTimer which sends current time of c on its channel when c reaches time d.AddTo(c.Now()).
+// Timer with non-positive d fires immediately.
+func (c *FakeClock) NewTimer(d Interval) *FakeTimer {
+	ch := make(chan time.Time, 1)
+	timer := &fakeTimer{clock: c, c: ch}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.add(timer, d)
+	return &FakeTimer{C: ch, t: timer}
+}
+
+// After waits for c to reach time d.AddTo(c.Now()) and then sends current time of c on returned channel.
+// It is equivalent to NewTimer(d).C.
+func (c *FakeClock) After(d Interval) <-chan time.Time {
+	return c.NewTimer(d).C
+}
+
+// AfterFunc creates FakeTimer which calls f when c reaches time d.AddTo(c.Now()).
+// Function f is called synchronously by goroutine advancing c (or calling AfterFunc itself if d is non-positive), so it may use c, but should not block.
+// Channel C of returned FakeTimer is not used.
+func (c *FakeClock) AfterFunc(d Interval, f func()) *FakeTimer {
+	timer := &fakeTimer{clock: c, f: f}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.add(timer, d)
+	return &FakeTimer{t: timer}
+}
+
+// Stop prevents the timer from firing. It returns true if the call stops the timer, false if the timer has already fired or been stopped.
+// Unlike time.Timer (before Go 1.23) channel does not contain stale value after Stop.
+func (t *FakeTimer) Stop() bool {
+	c := t.t.clock
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if t.t.c != nil {
+		t.t.drain()
+	}
+	return c.remove(t.t)
+}
+
+// Reset changes the timer to fire when clock reaches time d.AddTo(c.Now()).
+// It returns true if the timer had been active, false if the timer had fired or been stopped.
+// Not received value is removed from channel.
+func (t *FakeTimer) Reset(d Interval) bool {
+	c := t.t.clock
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if t.t.c != nil {
+		t.t.drain()
+	}
+	active := c.remove(t.t)
+	c.add(t.t, d)
+	return active
+}
+
+// FakeTicker is a ticker of FakeClock. It is similar to time.Ticker.
+type FakeTicker struct {
+	C <-chan time.Time
+	t *fakeTimer
+}
+
+// NewTicker returns FakeTicker which sends current time of c on its channel each time c reaches next tick.
+// Tick n is at time d.Mul(n).AddTo(start) where start is c.Now() at the moment of creation, so ticks do not drift on month ends.
+// Period d must be positive: all its parts must be non-negative and it must move time forward
+// (months or days must be positive if seconds are less than a nanosecond as AddTo rounds them to nanoseconds), otherwise NewTicker panics.
+// Ticker is stopped if d.Mul(n) overflows.
+func (c *FakeClock) NewTicker(d Interval) *FakeTicker {
+	checkTickerPeriod(d)
+	ch := make(chan time.Time, 1)
+	timer := &fakeTimer{clock: c, c: ch, period: d}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.add(timer, d)
+	return &FakeTicker{C: ch, t: timer}
+}
+
+// checkTickerPeriod panics if d is not valid ticker period.
+func checkTickerPeriod(d Interval) {
+	if d.Months < 0 || d.Days < 0 || d.SomeSeconds < 0 ||
+		(d.Months == 0 && d.Days == 0 && someSecondsChangePrecision(d.SomeSeconds, d.precision, NanosecondPrecision) == 0) {
+		panic(errors.New("Non-positive interval for NewTicker: " + d.String()))
+	}
+}
+
+// Stop turns off the ticker. After Stop, no more ticks will be sent.
+func (t *FakeTicker) Stop() {
+	c := t.t.clock
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	t.t.drain()
+	c.remove(t.t)
+}
+
+// Reset stops the ticker and restarts it from c.Now() with period d. It panics if d is not positive (see NewTicker).
+func (t *FakeTicker) Reset(d Interval) {
+	checkTickerPeriod(d)
+	c := t.t.clock
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	t.t.drain()
+	c.remove(t.t)
+	t.t.period = d
+	c.add(t.t, d)
+}
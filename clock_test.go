@@ -0,0 +1,234 @@
+package timehelper
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSinceClock(t *testing.T) {
+	from := time.Date(2016, 1, 31, 10, 0, 0, 0, time.UTC)
+	c := NewFakeClock(from)
+
+	type testElement struct {
+		advance  Interval
+		since    Interval
+		extended Interval
+	}
+
+	test := []testElement{
+		// 0
+		{
+			advance:  Interval{},
+			since:    Interval{0, 0, 0, GoPrecision},
+			extended: Interval{0, 0, 0, GoPrecision},
+		},
+
+		// 1
+		{
+			advance:  Interval{0, 0, 90 * NanosecsInSec, GoPrecision},
+			since:    Interval{0, 0, 90 * NanosecsInSec, GoPrecision},
+			extended: Interval{0, 0, 90 * NanosecsInSec, GoPrecision},
+		},
+
+		// 2
+		{
+			advance:  Interval{1, 0, 0, SecondPrecision}, // January 31 + 1 mon = March 2 (2016 is leap year)
+			since:    Interval{0, 0, (31*SecsInDay + 90) * NanosecsInSec, GoPrecision},
+			extended: Interval{2, -29, 90 * NanosecsInSec, GoPrecision},
+		},
+
+		// 3
+		{
+			advance:  Interval{0, -1, -90 * MillisecsInSec, MillisecondPrecision},
+			since:    Interval{0, 0, 30 * SecsInDay * NanosecsInSec, GoPrecision},
+			extended: Interval{2, -30, 0, GoPrecision},
+		},
+	}
+
+	for j, v := range test {
+		c.Advance(v.advance)
+		if i := SinceClock(c, from); i != v.since {
+			t.Errorf("Test-%v. SinceClock expected: %v, got: %v", j, v.since, i)
+		}
+		if i := SinceExtendedClock(c, from); i != v.extended {
+			t.Errorf("Test-%v. SinceExtendedClock expected: %v, got: %v", j, v.extended, i)
+		}
+	}
+
+	c.Set(from.Add(-time.Hour))
+	if i := SinceClock(c, from); i != FromDuration(-time.Hour) {
+		t.Errorf("Expected: %v, got: %v", FromDuration(-time.Hour), i)
+	}
+
+	if i := SinceClock(nil, time.Now()); i.SomeSeconds < 0 || i.SomeSeconds > NanosecsInSec {
+		t.Errorf("Unexpected SinceClock with nil clock: %v", i)
+	}
+}
+
+func TestFakeClock_Advance(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skip("Unable to load location: ", err)
+	}
+	c := NewFakeClock(time.Date(2016, 3, 26, 12, 0, 0, 0, loc))
+
+	// Day with DST change is 23 hours long, but wall clock is kept.
+	if now := c.Advance(Interval{0, 1, 0, SecondPrecision}); !now.Equal(time.Date(2016, 3, 27, 12, 0, 0, 0, loc)) || SinceClock(c, time.Date(2016, 3, 26, 12, 0, 0, 0, loc)) != FromDuration(23*time.Hour) {
+		t.Errorf("Unexpected time after advance: %v", now)
+	}
+}
+
+func TestFakeClock_NewTicker(t *testing.T) {
+	c := NewFakeClock(time.Date(2016, 1, 31, 10, 0, 0, 0, time.UTC))
+	ticker := c.NewTicker(Interval{1, 0, 0, SecondPrecision})
+	defer ticker.Stop()
+
+	test := []time.Time{
+		time.Date(2016, 3, 2, 10, 0, 0, 0, time.UTC),  // 0
+		time.Date(2016, 3, 31, 10, 0, 0, 0, time.UTC), // 1
+		time.Date(2016, 5, 1, 10, 0, 0, 0, time.UTC),  // 2
+		time.Date(2016, 5, 31, 10, 0, 0, 0, time.UTC), // 3
+		time.Date(2016, 7, 1, 10, 0, 0, 0, time.UTC),  // 4
+	}
+
+	for j, v := range test {
+		c.Advance(Interval{1, 0, 0, SecondPrecision})
+		select {
+		case tick := <-ticker.C:
+			if !tick.Equal(v) {
+				t.Errorf("Test-%v. Expected tick: %v, got: %v", j, v, tick)
+			}
+		default:
+			t.Errorf("Test-%v. Expected tick: %v, got nothing", j, v)
+		}
+	}
+
+	ticker.Reset(Interval{0, 0, SecsInHour, SecondPrecision})
+	c.Advance(Interval{0, 0, 3 * SecsInHour, SecondPrecision}) // Ticks are dropped if channel is full.
+	if tick := <-ticker.C; !tick.Equal(time.Date(2016, 7, 2, 11, 0, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected tick after reset: %v", tick)
+	}
+
+	// Sub-nanosecond seconds are allowed if period moves time forward after rounding.
+	ticker.Reset(Interval{0, 0, 1500, PicosecondPrecision})
+	c.Advance(Interval{0, 0, 5, NanosecondPrecision})
+	if tick := <-ticker.C; !tick.Equal(time.Date(2016, 7, 2, 13, 0, 0, 2, time.UTC)) {
+		t.Errorf("Unexpected tick with sub-nanosecond period: %v", tick)
+	}
+
+	ticker.Stop()
+	c.Advance(Interval{1, 0, 0, SecondPrecision})
+	select {
+	case tick := <-ticker.C:
+		t.Errorf("Unexpected tick after stop: %v", tick)
+	default:
+	}
+
+	for j, v := range []Interval{{}, {0, 0, 0, MicrosecondPrecision}, {1, -1, 0, SecondPrecision}, {0, 0, -1, SecondPrecision}, {0, 0, 1, PicosecondPrecision}, {0, 0, 499, PicosecondPrecision}} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Test-%v. Expected panic for period %v", j, v)
+				}
+			}()
+			c.NewTicker(v)
+		}()
+	}
+}
+
+func TestFakeClock_AfterFunc(t *testing.T) {
+	start := time.Date(2016, 1, 31, 10, 0, 0, 0, time.UTC)
+	c := NewFakeClock(start)
+
+	var fired []string
+	add := func(name string, d Interval) *FakeTimer {
+		return c.AfterFunc(d, func() {
+			fired = append(fired, name+" "+c.Now().Format(SimpleLayout))
+		})
+	}
+
+	add("month", Interval{1, 0, 0, SecondPrecision})
+	add("day", Interval{0, 1, 0, SecondPrecision})
+	add("hours", Interval{0, 0, 24 * SecsInHour, SecondPrecision})
+	stopped := add("stopped", Interval{0, 0, 1, SecondPrecision})
+	add("nested", Interval{0, 0, SecsInHour, SecondPrecision}).Reset(Interval{0, 2, 0, SecondPrecision})
+	c.AfterFunc(Interval{0, 0, 2 * SecsInHour, SecondPrecision}, func() {
+		add("created", Interval{0, 0, SecsInHour, SecondPrecision})
+	})
+
+	if !stopped.Stop() || stopped.Stop() {
+		t.Error("Unexpected result of Stop")
+	}
+
+	now := c.Advance(Interval{0, 0, 100 * SecsInDay, SecondPrecision})
+	if !now.Equal(start.Add(100 * SecsInDay * time.Second)) {
+		t.Errorf("Unexpected time after advance: %v", now)
+	}
+
+	expected := []string{
+		"created 2016-01-31 13:00:00",
+		"day 2016-02-01 10:00:00",
+		"hours 2016-02-01 10:00:00",
+		"nested 2016-02-02 10:00:00",
+		"month 2016-03-02 10:00:00",
+	}
+	if len(fired) != len(expected) {
+		t.Fatalf("Expected: %v, got: %v", expected, fired)
+	}
+	for j := range expected {
+		if fired[j] != expected[j] {
+			t.Errorf("Test-%v. Expected: %v, got: %v", j, expected[j], fired[j])
+		}
+	}
+
+	fired = nil
+	add("immediate", Interval{0, 0, -1, SecondPrecision})
+	if len(fired) != 1 || fired[0] != "immediate "+now.Format(SimpleLayout) {
+		t.Errorf("Unexpected immediate timer: %v", fired)
+	}
+}
+
+func TestFakeClock_After(t *testing.T) {
+	loc, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skip("Unable to load location: ", err)
+	}
+	start := time.Date(2016, 3, 26, 12, 0, 0, 0, loc)
+	c := NewFakeClock(start)
+
+	ch := c.After(Interval{0, 1, 0, SecondPrecision})
+	timer := c.NewTimer(Interval{0, 0, 24 * SecsInHour, SecondPrecision})
+
+	c.Advance(Interval{0, 0, 23 * SecsInHour, SecondPrecision})
+	select {
+	case v := <-ch:
+		if !v.Equal(time.Date(2016, 3, 27, 12, 0, 0, 0, loc)) {
+			t.Errorf("Unexpected time from After: %v", v)
+		}
+	default:
+		t.Error("After is not fired")
+	}
+	select {
+	case v := <-timer.C:
+		t.Errorf("Timer fired too early: %v", v)
+	default:
+	}
+
+	c.Set(start.Add(24 * time.Hour))
+	if timer.Reset(Interval{0, 0, SecsInHour, SecondPrecision}) {
+		t.Error("Reset of fired timer returns true")
+	}
+	select {
+	case v := <-timer.C:
+		t.Errorf("Stale value after Reset: %v", v)
+	default:
+	}
+	c.Advance(Interval{0, 0, SecsInHour, SecondPrecision})
+	if v := <-timer.C; !v.Equal(start.Add(25 * time.Hour)) {
+		t.Errorf("Unexpected time from reset timer: %v", v)
+	}
+
+	if v := <-c.After(Interval{}); !v.Equal(c.Now()) {
+		t.Errorf("Unexpected time from After with zero interval: %v", v)
+	}
+}
@@ -1,11 +1,9 @@
 package timehelper
 
 import (
-	"errors"
 	"github.com/apaxa-io/mathhelper"
 	"github.com/apaxa-io/strconvhelper"
 	"github.com/apaxa-io/stringshelper"
-	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -23,10 +21,6 @@ const (
 	defaultPrecision     = GoPrecision
 )
 
-// RE for parse interval in postgres style specification.
-// http://www.postgresql.org/docs/9.4/interactive/datatype-datetime.html#DATATYPE-INTERVAL-OUTPUT
-var re = regexp.MustCompile(`^(?:([+-]?[0-9]+) year)? ?(?:([+-]?[0-9]+) mons)? ?(?:([+-]?[0-9]+) days)? ?(?:([+-])?([0-9]+):([0-9]+):([0-9]+)(?:,|.([0-9]+))?)?$`)
-
 // Interval represent time interval in Postgres-compatible way.
 // It consists of 3 public fields:
 // 	Months - number months
@@ -102,116 +96,48 @@ func Year() Interval {
 }
 
 // Parse parses incoming string and extract interval with requested precision p.
-// Format is postgres style specification for interval output format.
+// Format is postgres style specification for interval output format (both singular and plural unit names are accepted).
+// Use ParseStyle for other PostgreSQL IntervalStyle formats, ParseLayout for custom layouts, ParseInput for lenient parsing of user input
+// and ParseWithOptions for strict validation.
 // Examples:
 // 	-1 year 2 mons -3 days 04:05:06.789
 // 	1 mons
 // 	2 year -34:56:78
 // 	00:00:00
-func Parse(s string, p uint8) (i Interval, err error) {
-	//TODO string of 1-3 spaces are parse ok
-	//TODO add check for overflow
-
-	if p > maxPrecision {
-		i.precision = maxPrecision
-	} else {
-		i.precision = p
-	}
-
-	parts := re.FindStringSubmatch(s)
-	if parts == nil || len(parts) != 9 {
-		err = errors.New("Unable to parse interval from string " + s)
-		return
-	}
-
-	var ti int64
-
-	// Store as months:
-
-	// years
-	if parts[1] != "" {
-		ti, err = strconv.ParseInt(parts[1], 10, 32)
-		if err != nil {
-			return
-		}
-		i.Months = int32(ti) * MonthsInYear
-	}
-
-	// months
-	if parts[2] != "" {
-		ti, err = strconv.ParseInt(parts[2], 10, 32)
-		if err != nil {
-			return
-		}
-		i.Months += int32(ti)
-	}
-
-	// Store as days:
-
-	// days
-	if parts[3] != "" {
-		ti, err = strconv.ParseInt(parts[3], 10, 32)
-		if err != nil {
-			return
-		}
-		i.Days = int32(ti)
-	}
-
-	// Store as seconds:
-
-	negativeTime := parts[4] == "-" // TODO problem with MinInt64 because scanning as positive
-
-	// hours
-	if parts[5] != "" {
-		ti, err = strconv.ParseInt(parts[5], 10, 64)
-		if err != nil {
-			return
-		}
-		i.SomeSeconds = ti // Now somesecs contains hours
+// Errors are returned as *ParseError with failed component and offset, its cause may be checked with errors.Is:
+// ErrSyntax if string does not match format and ErrOverflow if parsed value does not fit in Interval.
+// Precision p greater than 12 is replaced with 12 (use ParseWithOptions in strict mode to get ErrPrecision instead).
+func Parse(s string, p uint8) (Interval, error) {
+	return ParseWithOptions(s, ParseOptions{Precision: p})
+}
+
+// parseFraction converts fraction part of seconds (digits after decimal separator) to units of precision p.
+// Extra digits are rounded: if first dropped digit is 5 or greater when result is rounded to upper.
+func parseFraction(s string, p uint8) (f int64, err error) {
+	if len(s) < int(p) {
+		s = stringshelper.PadRightWithByte(s, '0', int(p))
 	}
-	i.SomeSeconds *= MinsInHour // Now somesecs contains minutes
-
-	// minutes
-	if parts[6] != "" {
-		ti, err = strconv.ParseInt(parts[6], 10, 64)
-		if err != nil {
+	if p > 0 {
+		if f, err = strconv.ParseInt(s[:p], 10, 64); err != nil {
 			return
 		}
-		i.SomeSeconds += ti
 	}
-	i.SomeSeconds *= SecsInMin // Now somesecs contains seconds
-
-	// seconds
-	if parts[7] != "" {
-		ti, err = strconv.ParseInt(parts[7], 10, 64)
-		if err != nil {
-			return
-		}
-		i.SomeSeconds += ti
+	if len(s) > int(p) && s[p] >= '5' { // Round-to-upper if needed
+		f++
 	}
+	return
+}
 
-	i.SomeSeconds *= mathhelper.PowInt64(10, int64(p)) // Now someseconds contains required precision units
-
-	if parts[8] != "" {
-		if len(parts[8]) < int(p) {
-			parts[8] = stringshelper.PadRightWithByte(parts[8], '0', int(p))
-		}
-		ti, err = strconv.ParseInt(parts[8][:p], 10, 64)
-		if err != nil {
-			return
-		}
-		i.SomeSeconds += ti
-
-		if len(parts[8]) > int(p) && parts[8][int(p)] >= '5' { // Round-to-upper if needed
-			i.SomeSeconds++
-		}
+// formatFraction returns fraction part of seconds f (in units of precision p) as string with leading dot and without trailing zeros.
+// Sign of f is ignored. If f is zero when empty string returned.
+func formatFraction(f int64, p uint8) string {
+	if f == 0 {
+		return ""
 	}
-
-	if negativeTime {
-		i.SomeSeconds *= -1
+	if f < 0 {
+		f = -f
 	}
-
-	return
+	return "." + strings.TrimRight(stringshelper.PadLeftWithByte(strconvhelper.FormatInt64(f), '0', int(p)), "0")
 }
 
 // FromDuration returns new Interval equivalent for given time.Duration (convert time.Duration to Interval).
@@ -242,15 +168,15 @@ func DiffExtended(from, to time.Time) (i Interval) {
 }
 
 // Since returns elapsed time since given timestamp as Interval (=Diff(t, time.New())
-// Result always have months & days parts set to zero.
+// Result always have months & days parts set to zero. Use SinceClock to replace source of current time.
 func Since(t time.Time) Interval {
-	return Diff(t, time.Now())
+	return SinceClock(RealClock, t)
 }
 
 // SinceExtended returns elapsed time since given timestamp as Interval (=DiffExtended(t, time.New())
-// Result may have non-zero months & days parts.
+// Result may have non-zero months & days parts. Use SinceExtendedClock to replace source of current time.
 func SinceExtended(t time.Time) Interval {
-	return DiffExtended(t, time.Now().In(t.Location()))
+	return SinceExtendedClock(RealClock, t)
 }
 
 // New returns zero interval with specified precision p
@@ -290,7 +216,8 @@ func (i Interval) Precision() uint8 {
 }
 
 // String returns string representation of interval.
-// Output format is the same as for Parse
+// Output format is the same as for Parse.
+// Use FormatStyle for other PostgreSQL IntervalStyle formats and Format for custom layouts.
 func (i Interval) String() string {
 	if i.Months == 0 && i.Days == 0 && i.SomeSeconds == 0 {
 		return "00:00:00"
@@ -347,8 +274,10 @@ func (i Interval) String() string {
 // It is required to pass number of days in month (usually 30 or something near)
 // and number of minutes in day (usually 1440) because of converting months and days parts of original Interval to time.Duration nanoseconds.
 // Warning: this method is inaccuracy because in real life daysInMonth & minutesInDay vary and depends on relative timestamp.
+// Seconds part is converted from precision of Interval to nanoseconds (rounded if precision is greater than GoPrecision).
+// Result silently overflows if it does not fit in time.Duration, use DurationChecked to detect this.
 func (i Interval) Duration(daysInMonth uint8, minutesInDay uint32) time.Duration {
-	return time.Duration((int64(i.Months)*int64(daysInMonth)+int64(i.Days))*int64(minutesInDay)*mathhelper.PowInt64(10, int64(i.precision))*SecsInMin + i.SomeSeconds)
+	return time.Duration((int64(i.Months)*int64(daysInMonth)+int64(i.Days))*int64(minutesInDay)*NanosecsInSec*SecsInMin + someSecondsChangePrecision(i.SomeSeconds, i.precision, NanosecondPrecision))
 }
 
 // someSecondsChangePrecision recalculates s (with precision from) to precision to and return result.
@@ -362,6 +291,7 @@ func someSecondsChangePrecision(s int64, from, to uint8) int64 {
 }
 
 // Add adds given Interval to original Interval.
+// Each part of result silently overflows, use AddChecked or AddSaturating to avoid this.
 // Original Interval will be changed.
 // TODO 'will be changed'?
 func (i Interval) Add(add Interval) Interval {
@@ -372,6 +302,7 @@ func (i Interval) Add(add Interval) Interval {
 }
 
 // Sub subtracts given Interval from original Interval.
+// Each part of result silently overflows, use SubChecked or SubSaturating to avoid this.
 // Original Interval will be changed.
 // TODO 'will be changed'?
 func (i Interval) Sub(sub Interval) Interval {
@@ -382,6 +313,7 @@ func (i Interval) Sub(sub Interval) Interval {
 }
 
 // Mul multiples interval by mul. Each part of Interval multiples independently.
+// Each part of result silently overflows, use MulChecked or MulSaturating to avoid this.
 // Original Interval will be changed.
 // TODO 'will be changed'?
 func (i Interval) Mul(mul int64) Interval {
@@ -391,6 +323,7 @@ func (i Interval) Mul(mul int64) Interval {
 
 // Div divides interval by mul. Each part of Interval divides independently.
 // Round rule: 0.4=>0 ; 0.5=>1 ; 0.6=>1 ; -0.4=>0 ; -0.5=>-1 ; -0.6=>-1
+// Use DivChecked to detect division by zero and overflow.
 // Original Interval will be changed.
 // TODO 'will be changed'?
 func (i Interval) Div(div int64) Interval {
@@ -402,7 +335,7 @@ func (i Interval) Div(div int64) Interval {
 
 // In counts how many i contains in i2 (=i2/i).
 // Round rule: 0.4=>0 ; 0.5=>1 ; 0.6=>1 ; -0.4=>0 ; -0.5=>-1 ; -0.6=>-1
-// TODO A lot of overflows
+// Intermediate values silently overflow for big intervals, use InChecked to avoid this.
 func (i Interval) In(i2 Interval) int64 {
 	iv := (int64(i.Months)*DaysInMonth+int64(i.Days))*SecsInDay*mathhelper.PowInt64(10, int64(i.precision)) + i.SomeSeconds
 	i2v := (int64(i2.Months)*DaysInMonth+int64(i2.Days))*SecsInDay*mathhelper.PowInt64(10, int64(i2.precision)) + i2.SomeSeconds
@@ -420,6 +353,7 @@ func (i Interval) In(i2 Interval) int64 {
 //   or
 //   2) all parts of "B" are less or equal to relative parts of "A".
 // In the other words, it is impossible to compare "30 days"-Interval with "1 month"-Interval.
+// Use Compare for PostgreSQL-compatible total order.
 func (i Interval) Comparable(i2 Interval) bool {
 	return i.LessOrEqual(i2) || i.GreaterOrEqual(i2)
 }
@@ -531,6 +465,19 @@ func (i Interval) NormalNanoseconds() int32 {
 	return int32(i.SomeSeconds % mathhelper.PowInt64(10, int64(i.precision)))
 }
 
+// timeParts splits seconds part to hours, minutes, seconds and fraction (in precision units).
+// All returned values have the same sign as SomeSeconds.
+func (i Interval) timeParts() (h, m, s, f int64) {
+	tmp := mathhelper.PowInt64(10, int64(i.precision))
+	f = i.SomeSeconds % tmp
+	s = i.SomeSeconds / tmp
+	h = s / SecsInHour
+	s -= h * SecsInHour
+	m = s / SecsInMin
+	s -= m * SecsInMin
+	return
+}
+
 // AddTo adds original Interval to given timestamp and return result.
 func (i Interval) AddTo(t time.Time) time.Time {
 	return t.AddDate(0, int(i.Months), int(i.Days)).Add(time.Duration(someSecondsChangePrecision(i.SomeSeconds, i.precision, NanosecondPrecision)))
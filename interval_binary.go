@@ -0,0 +1,104 @@
+package timehelper
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+	"strconv"
+)
+
+// Versions of binary encoding of Interval. Version is the first byte of encoded Interval.
+const (
+	// binaryVersionFixed is fixed size encoding: version (1 byte), precision (1 byte), months (int32), days (int32) and some seconds (int64) in network byte order.
+	binaryVersionFixed = 1
+	// binaryVersionVarint is variable size encoding: version (1 byte), precision (1 byte), months, days and some seconds as signed varints (see binary.AppendVarint).
+	binaryVersionVarint = 2
+)
+
+// BinaryLen is length of Interval encoded by MarshalBinary.
+const BinaryLen = 1 + 1 + 4 + 4 + 8
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+// Interval is encoded in fixed size (BinaryLen bytes) versioned format which keeps all fields including precision.
+// Use MarshalBinaryVarint for more compact encoding.
+func (i Interval) MarshalBinary() ([]byte, error) {
+	return i.AppendBinary(make([]byte, 0, BinaryLen))
+}
+
+// AppendBinary appends Interval encoded as by MarshalBinary to buf and returns the extended buffer.
+func (i Interval) AppendBinary(buf []byte) ([]byte, error) {
+	buf = append(buf, binaryVersionFixed, i.precision)
+	buf = binary.BigEndian.AppendUint32(buf, uint32(i.Months))
+	buf = binary.BigEndian.AppendUint32(buf, uint32(i.Days))
+	return binary.BigEndian.AppendUint64(buf, uint64(i.SomeSeconds)), nil
+}
+
+// MarshalBinaryVarint is similar to MarshalBinary but uses variable size encoding.
+// It is more compact for typical intervals (from 5 bytes for zero interval up to 22 bytes).
+// Result can be decoded by UnmarshalBinary.
+func (i Interval) MarshalBinaryVarint() ([]byte, error) {
+	return i.AppendBinaryVarint(nil)
+}
+
+// AppendBinaryVarint appends Interval encoded as by MarshalBinaryVarint to buf and returns the extended buffer.
+func (i Interval) AppendBinaryVarint(buf []byte) ([]byte, error) {
+	buf = append(buf, binaryVersionVarint, i.precision)
+	buf = binary.AppendVarint(buf, int64(i.Months))
+	buf = binary.AppendVarint(buf, int64(i.Days))
+	return binary.AppendVarint(buf, i.SomeSeconds), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+// It accepts data encoded by both MarshalBinary and MarshalBinaryVarint.
+// On error Interval is not changed.
+func (i *Interval) UnmarshalBinary(data []byte) error {
+	if len(data) < 2 {
+		return errors.New("Invalid length of binary interval: " + strconv.Itoa(len(data)))
+	}
+	if data[1] > maxPrecision {
+		return errors.New("Invalid interval precision " + strconv.Itoa(int(data[1])))
+	}
+	r := Interval{precision: data[1]}
+
+	switch data[0] {
+	case binaryVersionFixed:
+		if len(data) != BinaryLen {
+			return errors.New("Invalid length of binary interval: " + strconv.Itoa(len(data)))
+		}
+		r.Months = int32(binary.BigEndian.Uint32(data[2:]))
+		r.Days = int32(binary.BigEndian.Uint32(data[6:]))
+		r.SomeSeconds = int64(binary.BigEndian.Uint64(data[10:]))
+	case binaryVersionVarint:
+		data = data[2:]
+		var v [3]int64
+		for j := range v {
+			var n int
+			if v[j], n = binary.Varint(data); n <= 0 {
+				return errors.New("Invalid varint in binary interval")
+			}
+			data = data[n:]
+		}
+		if len(data) != 0 {
+			return errors.New("Invalid length of binary interval: " + strconv.Itoa(len(data)) + " extra bytes")
+		}
+		if v[0] < math.MinInt32 || v[0] > math.MaxInt32 || v[1] < math.MinInt32 || v[1] > math.MaxInt32 {
+			return &OverflowError{Op: "UnmarshalBinary"}
+		}
+		r.Months, r.Days, r.SomeSeconds = int32(v[0]), int32(v[1]), v[2]
+	default:
+		return errors.New("Unknown binary interval version " + strconv.Itoa(int(data[0])))
+	}
+
+	*i = r
+	return nil
+}
+
+// GobEncode implements the gob.GobEncoder interface. It is the same as MarshalBinary.
+func (i Interval) GobEncode() ([]byte, error) {
+	return i.MarshalBinary()
+}
+
+// GobDecode implements the gob.GobDecoder interface. It is the same as UnmarshalBinary.
+func (i *Interval) GobDecode(data []byte) error {
+	return i.UnmarshalBinary(data)
+}
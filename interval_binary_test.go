@@ -0,0 +1,93 @@
+package timehelper
+
+import (
+	"bytes"
+	"encoding/gob"
+	"math"
+	"testing"
+)
+
+func TestInterval_MarshalBinary(t *testing.T) {
+	test := []Interval{
+		{0, 0, 0, SecondPrecision},
+		{14, 3, 14706789, MillisecondPrecision},
+		{-14, -3, -1, PicosecondPrecision},
+		{math.MaxInt32, math.MinInt32, math.MinInt64, NanosecondPrecision},
+		{math.MinInt32, math.MaxInt32, math.MaxInt64, MicrosecondPrecision},
+	}
+
+	for j, v := range test {
+		data, err := v.MarshalBinary()
+		if err != nil || len(data) != BinaryLen {
+			t.Errorf("Test-%v. Unexpected encoding: %v (error: %v)", j, data, err)
+			continue
+		}
+		var i Interval
+		if err = i.UnmarshalBinary(data); err != nil || i != v {
+			t.Errorf("Test-%v. Expected: %v, got: %v (error: %v)", j, v, i, err)
+		}
+
+		data, err = v.MarshalBinaryVarint()
+		if err != nil {
+			t.Errorf("Test-%v. Unexpected error: %v", j, err)
+			continue
+		}
+		i = Interval{}
+		if err = i.UnmarshalBinary(data); err != nil || i != v {
+			t.Errorf("Test-%v. Varint expected: %v, got: %v (error: %v)", j, v, i, err)
+		}
+	}
+}
+
+func TestInterval_MarshalBinaryLayout(t *testing.T) {
+	i := Interval{14, -3, 1000, MillisecondPrecision}
+
+	data, _ := i.MarshalBinary()
+	expected := []byte{1, 3, 0, 0, 0, 14, 0xff, 0xff, 0xff, 0xfd, 0, 0, 0, 0, 0, 0, 0x03, 0xe8}
+	if !bytes.Equal(data, expected) {
+		t.Errorf("Expected: %v, got: %v", expected, data)
+	}
+
+	data, _ = i.MarshalBinaryVarint()
+	expected = []byte{2, 3, 28, 5, 0xd0, 0x0f}
+	if !bytes.Equal(data, expected) {
+		t.Errorf("Varint expected: %v, got: %v", expected, data)
+	}
+}
+
+func TestInterval_UnmarshalBinaryErrors(t *testing.T) {
+	test := [][]byte{
+		nil,
+		{1},
+		{1, 3, 0},
+		{1, 13, 0, 0, 0, 14, 0xff, 0xff, 0xff, 0xfd, 0, 0, 0, 0, 0, 0, 0x03, 0xe8},
+		{2, 3, 28, 5},
+		{2, 3, 28, 5, 0xd0, 0x0f, 0},
+		{2, 3, 0x80, 0x80, 0x80, 0x80, 0x20, 0, 0},
+		{3, 3, 0, 0, 0},
+	}
+
+	for j, v := range test {
+		i := Interval{1, 2, 3, SecondPrecision}
+		if err := i.UnmarshalBinary(v); err == nil || i != (Interval{1, 2, 3, SecondPrecision}) {
+			t.Errorf("Test-%v. Expected error and unchanged interval, got: %v (error: %v)", j, i, err)
+		}
+	}
+}
+
+func TestIntervalGob(t *testing.T) {
+	type element struct {
+		I Interval
+		P *Interval
+	}
+
+	v := element{Interval{14, 3, 14706789, MillisecondPrecision}, &Interval{-1, 0, 5, PicosecondPrecision}}
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(v); err != nil {
+		t.Fatal(err)
+	}
+	var r element
+	if err := gob.NewDecoder(&buf).Decode(&r); err != nil || r.I != v.I || r.P == nil || *r.P != *v.P {
+		t.Errorf("Expected: %v, got: %v (error: %v)", v, r, err)
+	}
+}
@@ -0,0 +1,219 @@
+package timehelper
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"time"
+)
+
+// OverflowError is returned by checked Interval operations if result does not fit in Interval (or in result type).
+type OverflowError struct {
+	Op string // Name of operation, for example "Add" or "Parse".
+}
+
+// Error implements the error interface.
+func (e *OverflowError) Error() string {
+	return "Interval " + e.Op + " overflow"
+}
+
+// ErrDivisionByZero is returned by DivChecked and InChecked if divisor is zero.
+var ErrDivisionByZero = errors.New("Interval division by zero")
+
+// bigInterval is an Interval with arbitrary size fields. It is used to perform exact calculations and detect overflows.
+type bigInterval struct {
+	months      big.Int
+	days        big.Int
+	someSeconds big.Int
+}
+
+// set sets fields from i. SomeSeconds are converted to precision p (rounded if p is less than precision of i).
+func (b *bigInterval) set(i Interval, p uint8) *bigInterval {
+	b.months.SetInt64(int64(i.Months))
+	b.days.SetInt64(int64(i.Days))
+	if p >= i.precision {
+		b.someSeconds.Mul(big.NewInt(i.SomeSeconds), pow10Big(p-i.precision))
+	} else {
+		b.someSeconds.Set(roundRat(new(big.Rat).SetFrac(big.NewInt(i.SomeSeconds), pow10Big(i.precision-p))))
+	}
+	return b
+}
+
+// interval builds Interval with precision p from fields. Fields which does not fit in Interval are saturated, in such case ok is false.
+func (b *bigInterval) interval(p uint8) (Interval, bool) {
+	months, ok1 := clampBig(&b.months, math.MinInt32, math.MaxInt32)
+	days, ok2 := clampBig(&b.days, math.MinInt32, math.MaxInt32)
+	someSeconds, ok3 := clampBig(&b.someSeconds, math.MinInt64, math.MaxInt64)
+	return Interval{int32(months), int32(days), someSeconds, p}, ok1 && ok2 && ok3
+}
+
+// clampBig converts v to int64 clamping it to [min; max]. If v has been clamped when ok is false.
+func clampBig(v *big.Int, min, max int64) (r int64, ok bool) {
+	switch {
+	case v.Cmp(big.NewInt(min)) < 0:
+		return min, false
+	case v.Cmp(big.NewInt(max)) > 0:
+		return max, false
+	default:
+		return v.Int64(), true
+	}
+}
+
+// pow10Big returns 10^p.
+func pow10Big(p uint8) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(p)), nil)
+}
+
+// addSaturating returns i+add (or i-add if sub is true) with saturated fields and reports if there was no saturation.
+func (i Interval) addSaturating(add Interval, sub bool) (Interval, bool) {
+	var a, b bigInterval
+	a.set(i, i.precision)
+	b.set(add, i.precision)
+	if sub {
+		a.months.Sub(&a.months, &b.months)
+		a.days.Sub(&a.days, &b.days)
+		a.someSeconds.Sub(&a.someSeconds, &b.someSeconds)
+	} else {
+		a.months.Add(&a.months, &b.months)
+		a.days.Add(&a.days, &b.days)
+		a.someSeconds.Add(&a.someSeconds, &b.someSeconds)
+	}
+	return a.interval(i.precision)
+}
+
+// mulSaturating returns i*mul with saturated fields and reports if there was no saturation.
+func (i Interval) mulSaturating(mul int64) (Interval, bool) {
+	var a bigInterval
+	a.set(i, i.precision)
+	m := big.NewInt(mul)
+	a.months.Mul(&a.months, m)
+	a.days.Mul(&a.days, m)
+	a.someSeconds.Mul(&a.someSeconds, m)
+	return a.interval(i.precision)
+}
+
+// SetPrecisionChecked is similar to SetPrecision but returns OverflowError if seconds part does not fit in Interval with new precision.
+func (i Interval) SetPrecisionChecked(p uint8) (Interval, error) {
+	if p > maxPrecision {
+		p = maxPrecision
+	}
+	var a bigInterval
+	r, ok := a.set(i, p).interval(p)
+	if !ok {
+		return NewInterval(p), &OverflowError{Op: "SetPrecision"}
+	}
+	return r, nil
+}
+
+// AddChecked is similar to Add but returns OverflowError if any part of result does not fit in Interval.
+func (i Interval) AddChecked(add Interval) (Interval, error) {
+	r, ok := i.addSaturating(add, false)
+	if !ok {
+		return NewInterval(i.precision), &OverflowError{Op: "Add"}
+	}
+	return r, nil
+}
+
+// AddSaturating is similar to Add but each part of result which does not fit in Interval is clamped to the limits of its type.
+func (i Interval) AddSaturating(add Interval) Interval {
+	r, _ := i.addSaturating(add, false)
+	return r
+}
+
+// SubChecked is similar to Sub but returns OverflowError if any part of result does not fit in Interval.
+func (i Interval) SubChecked(sub Interval) (Interval, error) {
+	r, ok := i.addSaturating(sub, true)
+	if !ok {
+		return NewInterval(i.precision), &OverflowError{Op: "Sub"}
+	}
+	return r, nil
+}
+
+// SubSaturating is similar to Sub but each part of result which does not fit in Interval is clamped to the limits of its type.
+func (i Interval) SubSaturating(sub Interval) Interval {
+	r, _ := i.addSaturating(sub, true)
+	return r
+}
+
+// MulChecked is similar to Mul but returns OverflowError if any part of result does not fit in Interval.
+func (i Interval) MulChecked(mul int64) (Interval, error) {
+	r, ok := i.mulSaturating(mul)
+	if !ok {
+		return NewInterval(i.precision), &OverflowError{Op: "Mul"}
+	}
+	return r, nil
+}
+
+// MulSaturating is similar to Mul but each part of result which does not fit in Interval is clamped to the limits of its type.
+func (i Interval) MulSaturating(mul int64) Interval {
+	r, _ := i.mulSaturating(mul)
+	return r
+}
+
+// DivChecked is similar to Div but returns ErrDivisionByZero if div is zero and OverflowError if any part of result does not fit in Interval
+// (this is possible only if minimal value divided by -1).
+func (i Interval) DivChecked(div int64) (Interval, error) {
+	if div == 0 {
+		return NewInterval(i.precision), ErrDivisionByZero
+	}
+
+	var a bigInterval
+	a.set(i, i.precision)
+	d := big.NewInt(div)
+	a.months.Set(roundRat(new(big.Rat).SetFrac(&a.months, d)))
+	a.days.Set(roundRat(new(big.Rat).SetFrac(&a.days, d)))
+	a.someSeconds.Set(roundRat(new(big.Rat).SetFrac(&a.someSeconds, d)))
+
+	r, ok := a.interval(i.precision)
+	if !ok {
+		return NewInterval(i.precision), &OverflowError{Op: "Div"}
+	}
+	return r, nil
+}
+
+// InChecked is similar to In but returns ErrDivisionByZero if i is zero and OverflowError if result does not fit in int64.
+// Calculation is performed exactly (without intermediate overflows).
+func (i Interval) InChecked(i2 Interval) (int64, error) {
+	p := i.precision
+	if i2.precision > p {
+		p = i2.precision
+	}
+
+	iv, i2v := i.approxSomeSeconds(p), i2.approxSomeSeconds(p)
+	if iv.Sign() == 0 {
+		return 0, ErrDivisionByZero
+	}
+
+	r, ok := clampBig(roundRat(new(big.Rat).SetFrac(i2v, iv)), math.MinInt64, math.MaxInt64)
+	if !ok {
+		return 0, &OverflowError{Op: "In"}
+	}
+	return r, nil
+}
+
+// approxSomeSeconds returns whole interval as number of units of precision p assuming DaysInMonth days in month and SecsInDay seconds in day.
+func (i Interval) approxSomeSeconds(p uint8) *big.Int {
+	var a bigInterval
+	a.set(i, p)
+	r := new(big.Int).Mul(&a.months, big.NewInt(DaysInMonth))
+	r.Add(r, &a.days)
+	r.Mul(r, big.NewInt(SecsInDay))
+	r.Mul(r, pow10Big(p))
+	return r.Add(r, &a.someSeconds)
+}
+
+// DurationChecked is similar to Duration but returns OverflowError if result does not fit in time.Duration.
+func (i Interval) DurationChecked(daysInMonth uint8, minutesInDay uint32) (time.Duration, error) {
+	var a bigInterval
+	a.set(i, NanosecondPrecision)
+	r := new(big.Int).Mul(&a.months, big.NewInt(int64(daysInMonth)))
+	r.Add(r, &a.days)
+	r.Mul(r, big.NewInt(int64(minutesInDay)*SecsInMin*NanosecsInSec))
+	r.Add(r, &a.someSeconds)
+
+	d, ok := clampBig(r, math.MinInt64, math.MaxInt64)
+	if !ok {
+		return 0, &OverflowError{Op: "Duration"}
+	}
+	return time.Duration(d), nil
+}
@@ -0,0 +1,355 @@
+package timehelper
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestAddCheckedAndSaturating(t *testing.T) {
+	type testElement struct {
+		i   Interval
+		add Interval
+		res Interval
+		sat Interval
+		err bool
+	}
+
+	test := []testElement{
+		// 0
+		{
+			i:   Interval{-14, 3, -14706 * 1e9, NanosecondPrecision},
+			add: Interval{1, 2, 3 * 1e9, NanosecondPrecision},
+			res: Interval{-13, 5, -14703 * 1e9, NanosecondPrecision},
+			sat: Interval{-13, 5, -14703 * 1e9, NanosecondPrecision},
+		},
+
+		// 1
+		{
+			i:   Interval{math.MaxInt32, 0, 0, NanosecondPrecision},
+			add: Interval{1, 1, 0, NanosecondPrecision},
+			sat: Interval{math.MaxInt32, 1, 0, NanosecondPrecision},
+			err: true,
+		},
+
+		// 2
+		{
+			i:   Interval{0, math.MinInt32, math.MinInt64, NanosecondPrecision},
+			add: Interval{0, -1, -1, NanosecondPrecision},
+			sat: Interval{0, math.MinInt32, math.MinInt64, NanosecondPrecision},
+			err: true,
+		},
+
+		// 3
+		{
+			i:   Interval{0, 0, 1, PicosecondPrecision},
+			add: Interval{0, 0, math.MaxInt64, SecondPrecision},
+			sat: Interval{0, 0, math.MaxInt64, PicosecondPrecision},
+			err: true,
+		},
+
+		// 4
+		{
+			i:   Interval{0, 0, -math.MaxInt64 + 1, PicosecondPrecision},
+			add: Interval{0, 0, math.MaxInt64 / 1000000, MicrosecondPrecision},
+			res: Interval{0, 0, -math.MaxInt64 + 1 + math.MaxInt64/1000000*1000000, PicosecondPrecision},
+			sat: Interval{0, 0, -math.MaxInt64 + 1 + math.MaxInt64/1000000*1000000, PicosecondPrecision},
+		},
+	}
+
+	for j, v := range test {
+		i, err := v.i.AddChecked(v.add)
+		if (err != nil) != v.err {
+			t.Errorf("Test-%v. Unexpected error: %v", j, err)
+		} else if !v.err && (i != v.res) {
+			t.Errorf("Test-%v. Wrong checked add.\nExpected:\n%v\ngot:\n%v", j, v.res, i)
+		}
+		if i = v.i.AddSaturating(v.add); i != v.sat {
+			t.Errorf("Test-%v. Wrong saturating add.\nExpected:\n%v\ngot:\n%v", j, v.sat, i)
+		}
+	}
+}
+
+func TestSubCheckedAndSaturating(t *testing.T) {
+	type testElement struct {
+		i   Interval
+		sub Interval
+		res Interval
+		sat Interval
+		err bool
+	}
+
+	test := []testElement{
+		// 0
+		{
+			i:   Interval{1, 2, 3 * 1e9, NanosecondPrecision},
+			sub: Interval{2, 3, 4 * 1e9, NanosecondPrecision},
+			res: Interval{-1, -1, -1 * 1e9, NanosecondPrecision},
+			sat: Interval{-1, -1, -1 * 1e9, NanosecondPrecision},
+		},
+
+		// 1
+		{
+			i:   Interval{0, 0, 0, NanosecondPrecision},
+			sub: Interval{math.MinInt32, 0, math.MinInt64, NanosecondPrecision},
+			sat: Interval{math.MaxInt32, 0, math.MaxInt64, NanosecondPrecision},
+			err: true,
+		},
+
+		// 2
+		{
+			i:   Interval{-1, 0, 0, NanosecondPrecision},
+			sub: Interval{math.MaxInt32, 0, 0, NanosecondPrecision},
+			res: Interval{math.MinInt32, 0, 0, NanosecondPrecision},
+			sat: Interval{math.MinInt32, 0, 0, NanosecondPrecision},
+		},
+	}
+
+	for j, v := range test {
+		i, err := v.i.SubChecked(v.sub)
+		if (err != nil) != v.err {
+			t.Errorf("Test-%v. Unexpected error: %v", j, err)
+		} else if !v.err && (i != v.res) {
+			t.Errorf("Test-%v. Wrong checked sub.\nExpected:\n%v\ngot:\n%v", j, v.res, i)
+		}
+		if i = v.i.SubSaturating(v.sub); i != v.sat {
+			t.Errorf("Test-%v. Wrong saturating sub.\nExpected:\n%v\ngot:\n%v", j, v.sat, i)
+		}
+	}
+}
+
+func TestMulCheckedAndSaturating(t *testing.T) {
+	type testElement struct {
+		i   Interval
+		mul int64
+		res Interval
+		sat Interval
+		err bool
+	}
+
+	test := []testElement{
+		// 0
+		{
+			i:   Interval{1, 2, 3 * 1e9, NanosecondPrecision},
+			mul: -2,
+			res: Interval{-2, -4, -6 * 1e9, NanosecondPrecision},
+			sat: Interval{-2, -4, -6 * 1e9, NanosecondPrecision},
+		},
+
+		// 1
+		{
+			i:   Interval{1, -1, 1e9, NanosecondPrecision},
+			mul: math.MaxInt64,
+			sat: Interval{math.MaxInt32, math.MinInt32, math.MaxInt64, NanosecondPrecision},
+			err: true,
+		},
+
+		// 2
+		{
+			i:   Interval{0, 0, math.MinInt64, NanosecondPrecision},
+			mul: -1,
+			sat: Interval{0, 0, math.MaxInt64, NanosecondPrecision},
+			err: true,
+		},
+
+		// 3
+		{
+			i:   Interval{0, 0, 0, NanosecondPrecision},
+			mul: math.MinInt64,
+			res: Interval{0, 0, 0, NanosecondPrecision},
+			sat: Interval{0, 0, 0, NanosecondPrecision},
+		},
+	}
+
+	for j, v := range test {
+		i, err := v.i.MulChecked(v.mul)
+		if (err != nil) != v.err {
+			t.Errorf("Test-%v. Unexpected error: %v", j, err)
+		} else if !v.err && (i != v.res) {
+			t.Errorf("Test-%v. Wrong checked mul.\nExpected:\n%v\ngot:\n%v", j, v.res, i)
+		}
+		if i = v.i.MulSaturating(v.mul); i != v.sat {
+			t.Errorf("Test-%v. Wrong saturating mul.\nExpected:\n%v\ngot:\n%v", j, v.sat, i)
+		}
+	}
+}
+
+func TestDivChecked(t *testing.T) {
+	type testElement struct {
+		i   Interval
+		div int64
+		res Interval
+		err error
+	}
+
+	test := []testElement{
+		// 0
+		{
+			i:   Interval{4, 6, 9, NanosecondPrecision},
+			div: 2,
+			res: Interval{2, 3, 5, NanosecondPrecision},
+		},
+
+		// 1
+		{
+			i:   Interval{4, -6, -9, NanosecondPrecision},
+			div: -2,
+			res: Interval{-2, 3, 5, NanosecondPrecision},
+		},
+
+		// 2
+		{
+			i:   Interval{4, 6, 8, NanosecondPrecision},
+			div: 0,
+			err: ErrDivisionByZero,
+		},
+
+		// 3
+		{
+			i:   Interval{math.MinInt32, 0, 0, NanosecondPrecision},
+			div: -1,
+			err: &OverflowError{Op: "Div"},
+		},
+	}
+
+	for j, v := range test {
+		i, err := v.i.DivChecked(v.div)
+		switch {
+		case v.err == nil && err != nil:
+			t.Errorf("Test-%v. Unexpected error: %v", j, err)
+		case v.err != nil && (err == nil || err.Error() != v.err.Error()):
+			t.Errorf("Test-%v. Expected error: %v, got: %v", j, v.err, err)
+		case v.err == nil && i != v.res:
+			t.Errorf("Test-%v. Wrong interval.\nExpected:%v\ngot:%v", j, v.res, i)
+		}
+	}
+}
+
+func TestInChecked(t *testing.T) {
+	type testElement struct {
+		i   Interval
+		i2  Interval
+		res int64
+		err bool
+	}
+
+	test := []testElement{
+		// 0
+		{
+			i:   Interval{0, 1, 0, NanosecondPrecision},
+			i2:  Interval{1, 0, 0, MicrosecondPrecision},
+			res: 30,
+		},
+
+		// 1
+		{
+			i:   Hour(),
+			i2:  Interval{0, 0, 5400, SecondPrecision},
+			res: 2,
+		},
+
+		// 2
+		{
+			i:   Interval{math.MaxInt32, math.MaxInt32, math.MaxInt64, PicosecondPrecision},
+			i2:  Interval{math.MaxInt32, math.MaxInt32, math.MaxInt64, PicosecondPrecision},
+			res: 1,
+		},
+
+		// 3
+		{
+			i:   Nanosecond(),
+			i2:  Interval{math.MaxInt32, 0, 0, SecondPrecision},
+			err: true,
+		},
+
+		// 4
+		{
+			i:   NewGoInterval(),
+			i2:  Hour(),
+			err: true,
+		},
+	}
+
+	for j, v := range test {
+		r, err := v.i.InChecked(v.i2)
+		if (err != nil) != v.err {
+			t.Errorf("Test-%v. Unexpected error: %v", j, err)
+		} else if !v.err && r != v.res {
+			t.Errorf("Test-%v. Expected: %v, got: %v", j, v.res, r)
+		}
+	}
+}
+
+func TestDurationChecked(t *testing.T) {
+	type testElement struct {
+		i            Interval
+		daysInMonth  uint8
+		minutesInDay uint32
+		d            time.Duration
+		err          bool
+	}
+
+	test := []testElement{
+		// 0
+		{
+			i:            Interval{10, 10, 1 * 1e9, NanosecondPrecision},
+			daysInMonth:  30,
+			minutesInDay: 1440,
+			d:            26784001 * time.Second,
+		},
+
+		// 1
+		{
+			i:            Interval{0, 1, 1500, MillisecondPrecision},
+			daysInMonth:  30,
+			minutesInDay: 1440,
+			d:            86401500 * time.Millisecond,
+		},
+
+		// 2
+		{
+			i:            Interval{10000, 0, 0, NanosecondPrecision},
+			daysInMonth:  30,
+			minutesInDay: 1440,
+			err:          true,
+		},
+
+		// 3
+		{
+			i:            Interval{0, 0, math.MaxInt64, PicosecondPrecision},
+			daysInMonth:  30,
+			minutesInDay: 1440,
+			d:            time.Duration(math.MaxInt64/1000 + 1),
+		},
+	}
+
+	for j, v := range test {
+		d, err := v.i.DurationChecked(v.daysInMonth, v.minutesInDay)
+		if (err != nil) != v.err {
+			t.Errorf("Test-%v. Unexpected error: %v", j, err)
+		} else if !v.err && d != v.d {
+			t.Errorf("Test-%v. Wrong duration. Expected: %v, got: %v", j, v.d, d)
+		}
+	}
+}
+
+func TestParseOverflow(t *testing.T) {
+	test := []string{
+		"200000000 years",
+		"2562047:47:16.854775808",
+		"-2562047:47:16.854775809",
+	}
+
+	for j, v := range test {
+		_, err := Parse(v, NanosecondPrecision)
+		var e *OverflowError
+		if !errors.As(err, &e) {
+			t.Errorf("Test-%v. Expected overflow error for %v, got: %v", j, v, err)
+		}
+	}
+
+	if i, err := Parse("-2562047:47:16.854775808", NanosecondPrecision); err != nil || i.SomeSeconds != math.MinInt64 {
+		t.Errorf("Expected minimal interval, got: %v (error: %v)", i, err)
+	}
+}
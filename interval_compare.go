@@ -0,0 +1,53 @@
+package timehelper
+
+import "github.com/apaxa-io/mathhelper"
+
+// Compare returns an integer comparing two Intervals: 0 if a == b, -1 if a < b, and +1 if a > b.
+// Unlike LessOrEqual, Greater and other methods Compare defines a total order, the same as PostgreSQL uses for interval
+// (in ORDER BY, comparison operators and indexes): interval is flattened assuming 30 days in month and 24 hours in day.
+// So "1 mon" is equal to "30 days" and "720:00:00" for Compare (but not for Equal).
+// Intervals are compared at the greater precision of them, so for intervals with precision up to microseconds result is
+// exactly the same as in PostgreSQL.
+// Compare can be used directly with slices.SortFunc. Comparison is exact, it never overflows.
+func Compare(a, b Interval) int {
+	p := a.precision
+	if b.precision > p {
+		p = b.precision
+	}
+
+	aDays, aRem := a.cmpValue(p)
+	bDays, bRem := b.cmpValue(p)
+	switch {
+	case aDays < bDays:
+		return -1
+	case aDays > bDays:
+		return 1
+	case aRem < bRem:
+		return -1
+	case aRem > bRem:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// cmpValue returns interval flattened (assuming DaysInMonth days in month) to whole number of days
+// and remainder (in units of precision p, 0 <= rem < 1 day). Precision p must be greater or equal to precision of i.
+func (i Interval) cmpValue(p uint8) (days, rem int64) {
+	day := SecsInDay * mathhelper.PowInt64(10, int64(i.precision))
+	days = int64(i.Months)*DaysInMonth + int64(i.Days) + i.SomeSeconds/day
+	rem = i.SomeSeconds % day
+	if rem < 0 {
+		rem += day
+		days--
+	}
+	rem *= mathhelper.PowInt64(10, int64(p-i.precision))
+	return
+}
+
+// IntervalSlice attaches the methods of sort.Interface to []Interval, sorting in increasing order defined by Compare.
+type IntervalSlice []Interval
+
+func (s IntervalSlice) Len() int           { return len(s) }
+func (s IntervalSlice) Less(i, j int) bool { return Compare(s[i], s[j]) < 0 }
+func (s IntervalSlice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
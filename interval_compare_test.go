@@ -0,0 +1,101 @@
+package timehelper
+
+import (
+	"math"
+	"sort"
+	"testing"
+)
+
+func TestCompare(t *testing.T) {
+	type testElement struct {
+		a   Interval
+		b   Interval
+		res int
+	}
+
+	test := []testElement{
+		// 0
+		{
+			a:   Interval{1, 0, 0, MicrosecondPrecision},
+			b:   Interval{0, 30, 0, NanosecondPrecision},
+			res: 0,
+		},
+
+		// 1
+		{
+			a:   Interval{1, 0, 0, MicrosecondPrecision},
+			b:   Interval{0, 0, 720 * SecsInHour, SecondPrecision},
+			res: 0,
+		},
+
+		// 2
+		{
+			a:   Interval{1, 0, 0, MicrosecondPrecision},
+			b:   Interval{0, 30, 1, NanosecondPrecision},
+			res: -1,
+		},
+
+		// 3
+		{
+			a:   Interval{0, 1, -1, PicosecondPrecision},
+			b:   Interval{0, 0, SecsInDay, SecondPrecision},
+			res: -1,
+		},
+
+		// 4
+		{
+			a:   Interval{-1, 0, 0, NanosecondPrecision},
+			b:   Interval{0, -29, -SecsInDay*NanosecsInSec + 1, NanosecondPrecision},
+			res: -1,
+		},
+
+		// 5
+		{
+			a:   Interval{0, 0, -1, SecondPrecision},
+			b:   Interval{0, 0, -1000001, MicrosecondPrecision},
+			res: 1,
+		},
+
+		// 6
+		{
+			a:   Interval{math.MaxInt32, math.MaxInt32, math.MaxInt64, SecondPrecision},
+			b:   Interval{math.MaxInt32, math.MaxInt32, math.MaxInt64, PicosecondPrecision},
+			res: 1,
+		},
+
+		// 7
+		{
+			a:   Interval{math.MinInt32, math.MinInt32, math.MinInt64, SecondPrecision},
+			b:   Interval{math.MinInt32, math.MinInt32, math.MinInt64, PicosecondPrecision},
+			res: -1,
+		},
+
+		// 8
+		{
+			a:   NewGoInterval(),
+			b:   NewInterval(SecondPrecision),
+			res: 0,
+		},
+	}
+
+	for j, v := range test {
+		if r := Compare(v.a, v.b); r != v.res {
+			t.Errorf("Test-%v. Compare(%v, %v). Expected: %v, got: %v", j, v.a, v.b, v.res, r)
+		}
+		if r := Compare(v.b, v.a); r != -v.res {
+			t.Errorf("Test-%v. Compare(%v, %v). Expected: %v, got: %v", j, v.b, v.a, -v.res, r)
+		}
+	}
+}
+
+func TestIntervalSlice(t *testing.T) {
+	s := IntervalSlice{Month(), Day().Mul(31), Hour().Mul(-1), Day().Mul(29), NewGoInterval(), Year()}
+	sort.Sort(s)
+
+	expected := []Interval{Hour().Mul(-1), NewGoInterval(), Day().Mul(29), Month(), Day().Mul(31), Year()}
+	for j := range expected {
+		if !s[j].Equal(expected[j]) {
+			t.Errorf("Test-%v. Expected: %v, got: %v", j, expected[j], s[j])
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package timehelper
+
+import "flag"
+
+// IntervalFlag is an Interval which implements flag.Value and flag.Getter interfaces,
+// so it can be used as command line flag: fs.Var((*IntervalFlag)(&i), name, usage).
+// It also implements pflag.Value interface (github.com/spf13/pflag), so the same can be done with pflag.FlagSet.
+// Flag value may be in any format accepted by UnmarshalText: any PostgreSQL IntervalStyle ("1 mon 2 days", "P1M2D")
+// or Go duration syntax ("30s", "1h30m"), so flags previously defined with flag.Duration keep working.
+type IntervalFlag Interval
+
+// String implements the flag.Value interface.
+func (f *IntervalFlag) String() string {
+	return Interval(*f).String()
+}
+
+// Set implements the flag.Value interface. Precision of Interval is kept (see UnmarshalText).
+func (f *IntervalFlag) Set(s string) error {
+	return (*Interval)(f).UnmarshalText([]byte(s))
+}
+
+// Get implements the flag.Getter interface. It returns Interval.
+func (f *IntervalFlag) Get() interface{} {
+	return Interval(*f)
+}
+
+// Type implements the pflag.Value interface.
+func (f *IntervalFlag) Type() string {
+	return "interval"
+}
+
+// IntervalVar defines an Interval flag with specified name, default value and usage string in fs (flag.CommandLine if fs is nil).
+// The argument p points to an Interval variable in which to store the value of the flag.
+// Precision of parsed values is the precision of default value (or DefaultTextPrecision if it is zero).
+func IntervalVar(fs *flag.FlagSet, p *Interval, name string, value Interval, usage string) {
+	if fs == nil {
+		fs = flag.CommandLine
+	}
+	*p = value
+	fs.Var((*IntervalFlag)(p), name, usage)
+}
@@ -0,0 +1,86 @@
+package timehelper
+
+import (
+	"flag"
+	"io"
+	"testing"
+)
+
+func TestIntervalVar(t *testing.T) {
+	type testElement struct {
+		args []string
+		i    Interval
+		err  bool
+	}
+
+	test := []testElement{
+		// 0
+		{
+			args: nil,
+			i:    Interval{0, 7, 0, MicrosecondPrecision},
+		},
+
+		// 1
+		{
+			args: []string{"--retention", "1 mon 2 days"},
+			i:    Interval{1, 2, 0, MicrosecondPrecision},
+		},
+
+		// 2
+		{
+			args: []string{"-retention=30s"},
+			i:    Interval{0, 0, 30 * MicrosecsInSec, MicrosecondPrecision},
+		},
+
+		// 3
+		{
+			args: []string{"-retention", "P1Y2DT0.5S"},
+			i:    Interval{12, 2, 500000, MicrosecondPrecision},
+		},
+
+		// 4
+		{
+			args: []string{"-retention", "1 week"},
+			err:  true,
+		},
+	}
+
+	for j, v := range test {
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		fs.SetOutput(io.Discard)
+		var i Interval
+		IntervalVar(fs, &i, "retention", Interval{0, 7, 0, MicrosecondPrecision}, "retention period")
+
+		err := fs.Parse(v.args)
+		if (err != nil) != v.err {
+			t.Errorf("Test-%v. Unexpected error: %v", j, err)
+		} else if !v.err && i != v.i {
+			t.Errorf("Test-%v. Expected: %v, got: %v", j, v.i, i)
+		}
+	}
+}
+
+func TestIntervalFlag(t *testing.T) {
+	// pflag.Value interface
+	var _ interface {
+		String() string
+		Set(string) error
+		Type() string
+	} = (*IntervalFlag)(nil)
+
+	f := IntervalFlag(Interval{1, 2, 3, SecondPrecision})
+	if s := f.String(); s != "1 mons 2 days 00:00:03" {
+		t.Errorf("Unexpected string: %v", s)
+	}
+	if i, ok := f.Get().(Interval); !ok || i != (Interval{1, 2, 3, SecondPrecision}) {
+		t.Errorf("Unexpected Get result: %v", f.Get())
+	}
+
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	var i Interval
+	IntervalVar(fs, &i, "timeout", Interval{}, "timeout")
+	if err := fs.Set("timeout", "1h30m"); err != nil || i != (Interval{0, 0, 5400 * NanosecsInSec, DefaultTextPrecision}) {
+		t.Errorf("Unexpected value: %v (error: %v)", i, err)
+	}
+}
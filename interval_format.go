@@ -0,0 +1,303 @@
+package timehelper
+
+import (
+	"github.com/apaxa-io/mathhelper"
+	"github.com/apaxa-io/strconvhelper"
+	"github.com/apaxa-io/stringshelper"
+	"math/big"
+	"strconv"
+)
+
+// LayoutParseError describes a problem parsing interval string with layout (see ParseLayout).
+// It is similar to time.ParseError.
+type LayoutParseError struct {
+	Layout     string
+	Value      string
+	LayoutElem string // Expected layout element (verb or literal text).
+	ValueElem  string // Rest of Value starting at Offset.
+	Offset     int    // Byte offset in Value.
+	Message    string // Used instead of default message if not empty.
+}
+
+// Error returns the string representation of a LayoutParseError.
+func (e *LayoutParseError) Error() string {
+	str := "Unable to parse interval " + strconv.Quote(e.Value) + " as " + strconv.Quote(e.Layout)
+	if e.Message != "" {
+		return str + e.Message
+	}
+	return str + ": cannot parse " + strconv.Quote(e.ValueElem) + " at offset " + strconv.Itoa(e.Offset) + " as " + strconv.Quote(e.LayoutElem)
+}
+
+// Format returns string representation of interval according to layout.
+// Layout is a text with verbs started with '%' (as in strftime):
+// 	%Y - years (as NormalYears)
+// 	%m - months (as NormalMonths)
+// 	%D - days
+// 	%H - hours (as NormalHours, sign of whole seconds part is printed here, so -1 second with "%H:%M:%S" is "-00:00:01")
+// 	%M - minutes, without sign
+// 	%S - seconds, without sign
+// 	%f - fraction of second, without sign
+// 	%F - fraction of second with leading '.' and without trailing zeros (empty if fraction is zero), as in String
+// 	%% - '%' character
+// Verb may be prefixed with width. For %Y, %m, %D, %H, %M and %S width is minimum number of digits, value is padded with zeros
+// (sign is not counted). By default %H, %M and %S are padded to 2 digits and other verbs are not padded.
+// For %f width is exact number of digits: fraction is truncated or padded with zeros on the right. By default it is interval precision.
+// %Y, %m, %D and %H may also be prefixed with '+' (before width) to print sign for non-negative values too.
+// Unknown verbs are copied to output as is.
+// Examples for interval "1 year 2 mons 3 days 04:05:06.789":
+// 	"%Y years %D days %H:%M:%S.%3f" => "1 years 3 days 04:05:06.789"
+// 	"%Y-%2m-%2D %H:%M:%S%F" => "1-02-03 04:05:06.789"
+// 	"%+D %H:%M:%S.%1f" => "+3 04:05:06.7"
+func (i Interval) Format(layout string) string {
+	h, m, s, f := i.timeParts()
+	negativeTime := i.SomeSeconds < 0
+
+	buf := make([]byte, 0, len(layout)+16)
+	for j := 0; j < len(layout); j++ {
+		if layout[j] != '%' {
+			buf = append(buf, layout[j])
+			continue
+		}
+
+		start := j
+		var plus bool
+		var width int
+		plus, width, j = scanLayoutVerb(layout, j)
+		if j == len(layout) {
+			buf = append(buf, layout[start:]...)
+			break
+		}
+
+		switch layout[j] {
+		case 'Y':
+			buf = appendFormatNumber(buf, int64(i.NormalYears()), i.NormalYears() < 0, plus, width)
+		case 'm':
+			buf = appendFormatNumber(buf, int64(i.NormalMonths()), i.NormalMonths() < 0, plus, width)
+		case 'D':
+			buf = appendFormatNumber(buf, int64(i.Days), i.Days < 0, plus, width)
+		case 'H':
+			buf = appendFormatNumber(buf, h, negativeTime, plus, defaultWidth(width, 2))
+		case 'M':
+			buf = appendFormatNumber(buf, m, false, false, defaultWidth(width, 2))
+		case 'S':
+			buf = appendFormatNumber(buf, s, false, false, defaultWidth(width, 2))
+		case 'f':
+			buf = append(buf, formatFractionWidth(f, i.precision, defaultWidth(width, int(i.precision)))...)
+		case 'F':
+			buf = append(buf, formatFraction(f, i.precision)...)
+		case '%':
+			buf = append(buf, '%')
+		default:
+			buf = append(buf, layout[start:j+1]...)
+		}
+	}
+	return string(buf)
+}
+
+// scanLayoutVerb scans flags of verb started at layout[j] (which is '%').
+// It returns '+' flag, width (-1 if not set) and index of verb character (len(layout) if layout ends before verb character).
+func scanLayoutVerb(layout string, j int) (plus bool, width int, end int) {
+	j++
+	plus = j < len(layout) && layout[j] == '+'
+	if plus {
+		j++
+	}
+	width = -1
+	for ; j < len(layout) && layout[j] >= '0' && layout[j] <= '9'; j++ {
+		if width < 0 {
+			width = 0
+		}
+		width = width*10 + int(layout[j]-'0')
+	}
+	return plus, width, j
+}
+
+// ParseLayout parses string s formatted according to layout (see Format) and extract interval with requested precision p.
+// Verbs %Y, %m, %D and %H accept optional sign ('+' flag is ignored), sign of %H is applied to %M, %S, %f and %F too.
+// If non-zero width is set for %Y, %m, %D, %H, %M or %S then exactly width digits are expected, otherwise all digits are consumed (at least one).
+// For %f exactly width digits are expected if width is set, otherwise all digits are consumed (may be none).
+// %F accepts '.' followed by digits or nothing. Fraction with more digits than p is rounded.
+// Other text in layout should match s exactly.
+// If s does not match layout when LayoutParseError returned, if parsed value does not fit in Interval when OverflowError returned.
+// Examples:
+// 	ParseLayout("%H:%M:%S", "0012:30:00", p) => "12:30:00"
+// 	ParseLayout("%Dd %Hh", "3d 04h", p) => "3 days 04:00:00"
+// 	ParseLayout("P%4Y-%2m-%2D", "P0003-02-01", p) => "3 year 2 mons 1 days"
+func ParseLayout(layout, s string, p uint8) (i Interval, err error) {
+	if p > maxPrecision {
+		p = maxPrecision
+	}
+	i.precision = p
+
+	var b bigInterval
+	var h, m, sec, f big.Int
+	negativeTime := false
+
+	pos := 0
+	for j := 0; j < len(layout); j++ {
+		if layout[j] != '%' {
+			if pos >= len(s) || s[pos] != layout[j] {
+				return i, newLayoutParseError(layout, s, layout[j:j+1], pos)
+			}
+			pos++
+			continue
+		}
+
+		start := j
+		var width int
+		_, width, j = scanLayoutVerb(layout, j)
+		if j == len(layout) {
+			if len(s)-pos < len(layout)-start || s[pos:pos+len(layout)-start] != layout[start:] {
+				return i, newLayoutParseError(layout, s, layout[start:], pos)
+			}
+			pos += len(layout) - start
+			break
+		}
+		elem := layout[start : j+1]
+
+		switch layout[j] {
+		case 'Y', 'm', 'D', 'H', 'M', 'S':
+			signed := layout[j] != 'M' && layout[j] != 'S'
+			negative := false
+			n := pos
+			if signed && n < len(s) && (s[n] == '+' || s[n] == '-') {
+				negative = s[n] == '-'
+				n++
+			}
+			digits := scanLayoutDigits(s[n:], width)
+			if digits == "" {
+				return i, newLayoutParseError(layout, s, elem, pos)
+			}
+			pos = n + len(digits)
+
+			var v big.Int
+			v.SetString(digits, 10)
+			switch layout[j] {
+			case 'Y':
+				v.Mul(&v, big.NewInt(MonthsInYear))
+				fallthrough
+			case 'm':
+				if negative {
+					v.Neg(&v)
+				}
+				b.months.Add(&b.months, &v)
+			case 'D':
+				if negative {
+					v.Neg(&v)
+				}
+				b.days.Add(&b.days, &v)
+			case 'H':
+				h.Add(&h, &v)
+				negativeTime = negative
+			case 'M':
+				m.Add(&m, &v)
+			case 'S':
+				sec.Add(&sec, &v)
+			}
+		case 'f', 'F':
+			n := pos
+			if layout[j] == 'F' {
+				if n >= len(s) || s[n] != '.' {
+					break
+				}
+				n++
+				width = -1
+			}
+			var digits string
+			if width != 0 {
+				digits = scanLayoutDigits(s[n:], width)
+			}
+			if (width > 0 || layout[j] == 'F') && digits == "" {
+				return i, newLayoutParseError(layout, s, elem, pos)
+			}
+			pos = n + len(digits)
+
+			var v int64
+			if v, err = parseFraction(digits, p); err != nil {
+				return i, newLayoutParseError(layout, s, elem, pos-len(digits))
+			}
+			f.Add(&f, big.NewInt(v))
+		case '%':
+			if pos >= len(s) || s[pos] != '%' {
+				return i, newLayoutParseError(layout, s, elem, pos)
+			}
+			pos++
+		default:
+			if len(s)-pos < len(elem) || s[pos:pos+len(elem)] != elem {
+				return i, newLayoutParseError(layout, s, elem, pos)
+			}
+			pos += len(elem)
+		}
+	}
+	if pos != len(s) {
+		return i, &LayoutParseError{Layout: layout, Value: s, ValueElem: s[pos:], Offset: pos, Message: ": extra text: " + strconv.Quote(s[pos:])}
+	}
+
+	b.someSeconds.Mul(&h, big.NewInt(MinsInHour))
+	b.someSeconds.Add(&b.someSeconds, &m)
+	b.someSeconds.Mul(&b.someSeconds, big.NewInt(SecsInMin))
+	b.someSeconds.Add(&b.someSeconds, &sec)
+	b.someSeconds.Mul(&b.someSeconds, pow10Big(p))
+	b.someSeconds.Add(&b.someSeconds, &f)
+	if negativeTime {
+		b.someSeconds.Neg(&b.someSeconds)
+	}
+
+	var ok bool
+	if i, ok = b.interval(p); !ok {
+		err = &OverflowError{Op: "ParseLayout"}
+	}
+	return
+}
+
+// newLayoutParseError returns LayoutParseError for layout element elem which does not match s at offset pos.
+func newLayoutParseError(layout, s, elem string, pos int) *LayoutParseError {
+	return &LayoutParseError{Layout: layout, Value: s, LayoutElem: elem, ValueElem: s[pos:], Offset: pos}
+}
+
+// scanLayoutDigits returns leading digits of s: exactly width digits (or empty string if there are not enough digits) if width is positive or all leading digits otherwise.
+func scanLayoutDigits(s string, width int) string {
+	n := 0
+	for n < len(s) && s[n] >= '0' && s[n] <= '9' && (width <= 0 || n < width) {
+		n++
+	}
+	if width > 0 && n != width {
+		return ""
+	}
+	return s[:n]
+}
+
+// defaultWidth returns width if it is set in layout or def otherwise.
+func defaultWidth(width, def int) int {
+	if width < 0 {
+		return def
+	}
+	return width
+}
+
+// appendFormatNumber appends absolute value of v padded with zeros up to width digits to buf.
+// It is prefixed with '-' if negative and with '+' if not negative and plus is set.
+func appendFormatNumber(buf []byte, v int64, negative, plus bool, width int) []byte {
+	switch {
+	case negative:
+		buf = append(buf, '-')
+	case plus:
+		buf = append(buf, '+')
+	}
+	return append(buf, stringshelper.PadLeftWithByte(strconvhelper.FormatInt64(mathhelper.AbsInt64(v)), '0', width)...)
+}
+
+// formatFractionWidth returns exactly width digits of fraction f with precision p (truncated or padded with zeros on the right).
+func formatFractionWidth(f int64, p uint8, width int) string {
+	if width == 0 {
+		return ""
+	}
+	str := ""
+	if p > 0 {
+		str = stringshelper.PadLeftWithByte(strconvhelper.FormatInt64(mathhelper.AbsInt64(f)), '0', int(p))
+	}
+	if width <= len(str) {
+		return str[:width]
+	}
+	return stringshelper.PadRightWithByte(str, '0', width)
+}
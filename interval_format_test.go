@@ -0,0 +1,222 @@
+package timehelper
+
+import "testing"
+
+func TestInterval_Format(t *testing.T) {
+	type testElement struct {
+		i      Interval
+		layout string
+		s      string
+	}
+
+	i := Interval{14, 3, 14706789, MillisecondPrecision} // 1 year 2 mons 3 days 04:05:06.789
+
+	test := []testElement{
+		// 0
+		{
+			i:      i,
+			layout: "%Y years %D days %H:%M:%S.%3f",
+			s:      "1 years 3 days 04:05:06.789",
+		},
+
+		// 1
+		{
+			i:      i,
+			layout: "%Y-%2m-%2D %H:%M:%S%F",
+			s:      "1-02-03 04:05:06.789",
+		},
+
+		// 2
+		{
+			i:      i,
+			layout: "%+D %H:%M:%S.%1f",
+			s:      "+3 04:05:06.7",
+		},
+
+		// 3
+		{
+			i:      i,
+			layout: "%S.%6f",
+			s:      "06.789000",
+		},
+
+		// 4
+		{
+			i:      i,
+			layout: "%1H%1M%1S",
+			s:      "456",
+		},
+
+		// 5
+		{
+			i:      Interval{-14, -3, -1, SecondPrecision},
+			layout: "%Y %m %D %H:%M:%S",
+			s:      "-1 -2 -3 -00:00:01",
+		},
+
+		// 6
+		{
+			i:      Interval{0, 0, 100 * SecsInHour, SecondPrecision},
+			layout: "%H:%M:%S.%f|%F|%3f",
+			s:      "100:00:00.||000",
+		},
+
+		// 7
+		{
+			i:      Interval{0, 0, -1500, MillisecondPrecision},
+			layout: "%+H:%M:%S.%f",
+			s:      "-00:00:01.500",
+		},
+
+		// 8
+		{
+			i:      Interval{0, 0, 0, SecondPrecision},
+			layout: "%+Y %+m 100%% %x %0f %",
+			s:      "+0 +0 100% %x  %",
+		},
+	}
+
+	for j, v := range test {
+		if s := v.i.Format(v.layout); s != v.s {
+			t.Errorf("Test-%v. Expected: %v, got: %v", j, v.s, s)
+		}
+	}
+}
+
+func TestParseLayout(t *testing.T) {
+	type testElement struct {
+		layout string
+		s      string
+		p      uint8
+		i      Interval
+		offset int // -1 if no error is expected
+	}
+
+	test := []testElement{
+		// 0
+		{
+			layout: "%H:%M:%S",
+			s:      "0012:30:00",
+			p:      SecondPrecision,
+			i:      Interval{0, 0, 12*SecsInHour + 30*SecsInMin, SecondPrecision},
+			offset: -1,
+		},
+
+		// 1
+		{
+			layout: "%Dd %Hh",
+			s:      "3d 04h",
+			p:      SecondPrecision,
+			i:      Interval{0, 3, 4 * SecsInHour, SecondPrecision},
+			offset: -1,
+		},
+
+		// 2
+		{
+			layout: "P%4Y-%2m-%2D",
+			s:      "P0003-02-01",
+			p:      SecondPrecision,
+			i:      Interval{38, 1, 0, SecondPrecision},
+			offset: -1,
+		},
+
+		// 3
+		{
+			layout: "%2H%2M%2S.%3f",
+			s:      "043005.250",
+			p:      MicrosecondPrecision,
+			i:      Interval{0, 0, (4*SecsInHour+30*SecsInMin+5)*1e6 + 250000, MicrosecondPrecision},
+			offset: -1,
+		},
+
+		// 4
+		{
+			layout: "%Y %m %D %H:%M:%S%F",
+			s:      "-1 -2 +3 -00:00:01.5",
+			p:      MillisecondPrecision,
+			i:      Interval{-14, 3, -1500, MillisecondPrecision},
+			offset: -1,
+		},
+
+		// 5
+		{
+			layout: "%S%F|%f 100%%",
+			s:      "1|123456 100%",
+			p:      3,
+			i:      Interval{0, 0, 1123, 3},
+			offset: -1,
+		},
+
+		// 6
+		{
+			layout: "%H:%M:%S",
+			s:      "12:3x:00",
+			offset: 4,
+		},
+
+		// 7
+		{
+			layout: "%Dd",
+			s:      "3 d",
+			offset: 1,
+		},
+
+		// 8
+		{
+			layout: "%2H%2M",
+			s:      "043",
+			offset: 2,
+		},
+
+		// 9
+		{
+			layout: "%H:%M",
+			s:      "12:30:00",
+			offset: 5,
+		},
+
+		// 10
+		{
+			layout: "%M",
+			s:      "-5",
+			offset: 0,
+		},
+	}
+
+	for j, v := range test {
+		i, err := ParseLayout(v.layout, v.s, v.p)
+		if v.offset < 0 {
+			if err != nil || i != v.i {
+				t.Errorf("Test-%v. Expected: %v, got: %v (error: %v)", j, v.i, i, err)
+			}
+			continue
+		}
+		if e, ok := err.(*LayoutParseError); !ok || e.Offset != v.offset {
+			t.Errorf("Test-%v. Expected LayoutParseError at offset %v, got: %v", j, v.offset, err)
+		}
+	}
+
+	if _, err := ParseLayout("%Y", "999999999999", SecondPrecision); err == nil {
+		t.Error("Expected overflow error")
+	} else if _, ok := err.(*OverflowError); !ok {
+		t.Errorf("Expected OverflowError, got: %v", err)
+	}
+}
+
+func TestFormatParseLayout(t *testing.T) {
+	layouts := []string{"%Y years %m months %D days %H:%M:%S.%f", "%+Y %+m %+D %+H:%M:%S%F"}
+	intervals := []Interval{
+		{14, 3, 14706789, MillisecondPrecision},
+		{-14, -3, -1500, MillisecondPrecision},
+		{1, -2, 3, MillisecondPrecision},
+		{0, 0, 0, MillisecondPrecision},
+	}
+
+	for j, layout := range layouts {
+		for k, v := range intervals {
+			if i, err := ParseLayout(layout, v.Format(layout), v.Precision()); err != nil || i != v {
+				t.Errorf("Test-%v-%v. Expected: %v, got: %v (error: %v)", j, k, v, i, err)
+			}
+		}
+	}
+}
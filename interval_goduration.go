@@ -0,0 +1,163 @@
+package timehelper
+
+import (
+	"github.com/apaxa-io/mathhelper"
+	"github.com/apaxa-io/strconvhelper"
+	"math/big"
+	"regexp"
+)
+
+// RE for parse one component of Go duration string extended with calendar units.
+var reGoDuration = regexp.MustCompile(`^([+-]?)([0-9]+(?:\.[0-9]*)?|\.[0-9]+)(ns|us|µs|μs|ms|mo|s|m|h|d|w|y)`)
+
+// ParseGoDuration parses Go duration string (as accepted by time.ParseDuration) and extract interval with requested precision p.
+// Additionally to "ns", "us" ("µs"), "ms", "s", "m" and "h" units calendar units are accepted: "d" (day), "w" (week, 7 days), "mo" (month) and "y" (year, 12 months).
+// Months and years are stored in Months, weeks and days are stored in Days, other units are stored in SomeSeconds.
+// Any component may be prefixed with sign. Sign applies to this component and all following components up to the next sign,
+// so leading sign applies to whole string (as in time.ParseDuration) and "1mo-2d3h" is "1 mons -2 days -03:00:00".
+// Fractional values are allowed for all units and are spilled down to smaller fields as in ParseInput: "1.5mo" is "1 mons 15 days".
+// If value does not fit in Interval when OverflowError returned.
+// Examples:
+// 	1h30m
+// 	250ms
+// 	1.5us
+// 	2mo3d4h
+// 	-1y
+func ParseGoDuration(s string, p uint8) (i Interval, err error) {
+	if p > maxPrecision {
+		p = maxPrecision
+	}
+	i.precision = p
+
+	switch s {
+	case "0", "+0", "-0":
+		return
+	case "":
+		err = &ParseError{Input: s, Err: ErrSyntax}
+		return
+	}
+
+	var a fieldsAccumulator
+	negative := false
+	for rest := s; rest != ""; {
+		parts := reGoDuration.FindStringSubmatch(rest)
+		if parts == nil {
+			err = &ParseError{Input: s, Offset: len(s) - len(rest), Err: ErrSyntax}
+			return
+		}
+		rest = rest[len(parts[0]):]
+
+		if parts[1] != "" {
+			negative = parts[1] == "-"
+		}
+		v, ok := new(big.Rat).SetString(parts[2])
+		if !ok {
+			err = &ParseError{Input: s, Offset: len(s) - len(rest) - len(parts[0]), Err: ErrSyntax}
+			return
+		}
+		if negative {
+			v.Neg(v)
+		}
+
+		switch parts[3] {
+		case "ns":
+			a.addSeconds(v, 1, NanosecsInSec)
+		case "us", "µs", "μs":
+			a.add(v, unitMicrosecond)
+		case "ms":
+			a.add(v, unitMillisecond)
+		case "s":
+			a.add(v, unitSecond)
+		case "m":
+			a.add(v, unitMinute)
+		case "h":
+			a.add(v, unitHour)
+		case "d":
+			a.add(v, unitDay)
+		case "w":
+			a.add(v, unitWeek)
+		case "mo":
+			a.add(v, unitMonth)
+		case "y":
+			a.add(v, unitYear)
+		}
+	}
+
+	return a.interval(p, "ParseGoDuration")
+}
+
+// GoDurationString returns compact string representation of interval in Go duration syntax extended with calendar units.
+// Output can be parsed by ParseGoDuration. If interval has only seconds part output can be parsed by time.ParseDuration too.
+// Unlike time.Duration.String zero components are omitted: "1h" instead of "1h0m0s".
+// Sign is written only when it changes (see ParseGoDuration), so negative interval has only leading "-".
+// Examples:
+// 	1y2mo3d4h5m6.789s
+// 	-1h30m
+// 	250ms
+// 	1mo-2d+3h
+// 	0s
+func (i Interval) GoDurationString() string {
+	if i.Months == 0 && i.Days == 0 && i.SomeSeconds == 0 {
+		return "0s"
+	}
+
+	str := ""
+	negative := false
+	addSign := func(v int64) {
+		switch {
+		case v < 0 && !negative:
+			str += "-"
+			negative = true
+		case v > 0 && negative:
+			str += "+"
+			negative = false
+		}
+	}
+	addPart := func(v int64, unit string) {
+		if v != 0 {
+			str += strconvhelper.FormatInt64(mathhelper.AbsInt64(v)) + unit
+		}
+	}
+
+	if i.Months != 0 {
+		addSign(int64(i.Months))
+		addPart(int64(i.NormalYears()), "y")
+		addPart(int64(i.NormalMonths()), "mo")
+	}
+	if i.Days != 0 {
+		addSign(int64(i.Days))
+		addPart(int64(i.Days), "d")
+	}
+	if i.SomeSeconds == 0 {
+		return str
+	}
+
+	addSign(i.SomeSeconds)
+	h, m, s, f := i.timeParts()
+	addPart(h, "h")
+	addPart(m, "m")
+	if s != 0 || h != 0 || m != 0 {
+		if s != 0 || f != 0 {
+			str += strconvhelper.FormatInt64(mathhelper.AbsInt64(s)) + formatFraction(f, i.precision) + "s"
+		}
+		return str
+	}
+
+	// Less than one second - use smaller units as time.Duration.String does.
+	f = mathhelper.AbsInt64(f)
+	var unit string
+	var e uint8 // precision of unit
+	switch {
+	case i.precision <= MillisecondPrecision || f >= mathhelper.PowInt64(10, int64(i.precision-MillisecondPrecision)):
+		unit, e = "ms", MillisecondPrecision
+	case i.precision <= MicrosecondPrecision || f >= mathhelper.PowInt64(10, int64(i.precision-MicrosecondPrecision)):
+		unit, e = "µs", MicrosecondPrecision
+	default:
+		unit, e = "ns", NanosecondPrecision
+	}
+	if i.precision <= e {
+		return str + strconvhelper.FormatInt64(f*mathhelper.PowInt64(10, int64(e-i.precision))) + unit
+	}
+	tmp := mathhelper.PowInt64(10, int64(i.precision-e))
+	return str + strconvhelper.FormatInt64(f/tmp) + formatFraction(f%tmp, i.precision-e) + unit
+}
@@ -0,0 +1,223 @@
+package timehelper
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseGoDuration(t *testing.T) {
+	type testElement struct {
+		s   string
+		p   uint8
+		i   Interval
+		err bool
+	}
+
+	test := []testElement{
+		// 0
+		{
+			s: "1h30m",
+			p: NanosecondPrecision,
+			i: Interval{0, 0, 5400 * NanosecsInSec, NanosecondPrecision},
+		},
+
+		// 1
+		{
+			s: "250ms",
+			p: MicrosecondPrecision,
+			i: Interval{0, 0, 250000, MicrosecondPrecision},
+		},
+
+		// 2
+		{
+			s: "1.5us",
+			p: NanosecondPrecision,
+			i: Interval{0, 0, 1500, NanosecondPrecision},
+		},
+
+		// 3
+		{
+			s: "1.5µs",
+			p: MicrosecondPrecision,
+			i: Interval{0, 0, 2, MicrosecondPrecision},
+		},
+
+		// 4
+		{
+			s: "2mo3d4h",
+			p: SecondPrecision,
+			i: Interval{2, 3, 4 * SecsInHour, SecondPrecision},
+		},
+
+		// 5
+		{
+			s: "-1y",
+			p: NanosecondPrecision,
+			i: Interval{-12, 0, 0, NanosecondPrecision},
+		},
+
+		// 6
+		{
+			s: "1mo-2d3h+4m",
+			p: SecondPrecision,
+			i: Interval{1, -2, -3*SecsInHour + 4*SecsInMin, SecondPrecision},
+		},
+
+		// 7
+		{
+			s: "1.5mo2w",
+			p: SecondPrecision,
+			i: Interval{1, 29, 0, SecondPrecision},
+		},
+
+		// 8
+		{
+			s: "-0",
+			p: 20,
+			i: Interval{0, 0, 0, maxPrecision},
+		},
+
+		// 9
+		{
+			s: "1ns",
+			p: PicosecondPrecision,
+			i: Interval{0, 0, 1000, PicosecondPrecision},
+		},
+
+		// 10
+		{
+			s:   "",
+			err: true,
+		},
+
+		// 11
+		{
+			s:   "1",
+			err: true,
+		},
+
+		// 12
+		{
+			s:   "1h 30m",
+			err: true,
+		},
+
+		// 13
+		{
+			s:   "1x",
+			err: true,
+		},
+	}
+
+	for j, v := range test {
+		i, err := ParseGoDuration(v.s, v.p)
+		if (err != nil) != v.err {
+			t.Errorf("Test-%v. Unexpected error: %v", j, err)
+		} else if !v.err && i != v.i {
+			t.Errorf("Test-%v. Expected: %v, got: %v", j, v.i, i)
+		}
+	}
+
+	for j, s := range []string{"300000000000y", "3000000000d", "9999999999999s"} {
+		if _, err := ParseGoDuration(s, MicrosecondPrecision); !errors.Is(err, ErrOverflow) {
+			t.Errorf("Test-%v. Expected overflow for %v, got: %v", j, s, err)
+		}
+	}
+
+	// Go duration syntax is detected by UnmarshalText.
+	var i Interval
+	if err := i.UnmarshalText([]byte("300000000000y")); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected overflow from UnmarshalText, got: %v", err)
+	}
+}
+
+func TestGoDurationString(t *testing.T) {
+	type testElement struct {
+		i Interval
+		s string
+	}
+
+	test := []testElement{
+		// 0
+		{
+			i: Interval{14, 3, 14706789 * 1e6, NanosecondPrecision},
+			s: "1y2mo3d4h5m6.789s",
+		},
+
+		// 1
+		{
+			i: Interval{0, 0, -5400 * NanosecsInSec, NanosecondPrecision},
+			s: "-1h30m",
+		},
+
+		// 2
+		{
+			i: Interval{0, 0, 250000, MicrosecondPrecision},
+			s: "250ms",
+		},
+
+		// 3
+		{
+			i: Interval{0, 0, -1500, NanosecondPrecision},
+			s: "-1.5µs",
+		},
+
+		// 4
+		{
+			i: Interval{0, 0, 1, PicosecondPrecision},
+			s: "0.001ns",
+		},
+
+		// 5
+		{
+			i: Interval{0, 0, 5, 1},
+			s: "500ms",
+		},
+
+		// 6
+		{
+			i: Interval{1, -2, 3 * SecsInHour, SecondPrecision},
+			s: "1mo-2d+3h",
+		},
+
+		// 7
+		{
+			i: Interval{-1, -2, -3, SecondPrecision},
+			s: "-1mo2d3s",
+		},
+
+		// 8
+		{
+			i: NewGoInterval(),
+			s: "0s",
+		},
+
+		// 9
+		{
+			i: Interval{0, 0, 3600, MillisecondPrecision},
+			s: "3.6s",
+		},
+	}
+
+	for j, v := range test {
+		if s := v.i.GoDurationString(); s != v.s {
+			t.Errorf("Test-%v. Expected: %v, got: %v", j, v.s, s)
+			continue
+		}
+		if i, err := ParseGoDuration(v.s, v.i.Precision()); err != nil || i != v.i {
+			t.Errorf("Test-%v. Parse expected: %v, got: %v (error: %v)", j, v.i, i, err)
+		}
+	}
+}
+
+func TestGoDurationStringTimeCompatibility(t *testing.T) {
+	test := []time.Duration{time.Hour + 30*time.Minute, -250 * time.Millisecond, 1500 * time.Nanosecond, 90 * time.Second}
+
+	for j, v := range test {
+		d, err := time.ParseDuration(FromDuration(v).GoDurationString())
+		if err != nil || d != v {
+			t.Errorf("Test-%v. Expected: %v, got: %v (error: %v)", j, v, d, err)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package timehelper
+
+import "github.com/apaxa-io/mathhelper"
+
+// HumanizeOptions controls Humanize and FormatLocale output.
+type HumanizeOptions struct {
+	// Units is maximum number of significant units in result (counting from the greatest non-zero unit, zero units are not printed).
+	// For example "2 years 3 mons 4 days 05:00:00" with Units = 2 is "about 2 years and 3 months". Zero means no limit.
+	Units int
+	// Round rounds the last printed unit by the rest of interval. If false the rest is truncated.
+	Round bool
+	// Relative formats interval as relative to now: negative interval as "3 hours ago", positive as "in 2 days" and zero as "now".
+	Relative bool
+}
+
+// Units used by Humanize.
+const (
+	humanYear = iota
+	humanMonth
+	humanDay
+	humanHour
+	humanMinute
+	humanSecond
+	humanUnitsCount
+)
+
+// humanUnitSizes are sizes of units in previous units (months in year, days in month and so on). Month is assumed to be DaysInMonth days.
+var humanUnitSizes = [humanUnitsCount]int64{0, MonthsInYear, DaysInMonth, HoursInDay, MinsInHour, SecsInMin}
+
+// humanUnitSeconds are approximate lengths of units in seconds.
+var humanUnitSeconds = [humanUnitsCount]int64{MonthsInYear * DaysInMonth * SecsInDay, DaysInMonth * SecsInDay, SecsInDay, SecsInHour, SecsInMin, 1}
+
+// Humanize returns interval in natural English language, for example:
+// 	2 years, 3 months and 4 days
+// 	about 5 minutes
+// 	3 hours ago
+// 	in 2 days
+// Interval is justified (see JustifyInterval) and decomposed as NormalYears, NormalMonths, Days, NormalHours, NormalMinutes and NormalSeconds.
+// If some non-zero part of interval is not printed (because of opts.Units or because it is less than a second) result is prefixed with "about".
+// Interval with only fraction of second is "less than a second".
+// Negative interval is prefixed with "minus" ("minus 3 hours", "about minus 5 minutes") unless opts.Relative is set.
+// Use FormatLocale for other languages.
+func (i Interval) Humanize(opts HumanizeOptions) string {
+	return LocaleEnglish.format(i, opts)
+}
+
+// humanize decomposes absolute value of interval into units according to opts.
+// It also returns sign of interval, if some non-zero part of interval is lost and if interval is less than a second (but not zero).
+func (i Interval) humanize(opts HumanizeOptions) (v [humanUnitsCount]int64, negative, approximate, lessThanSecond bool) {
+	negative = Compare(i, Interval{}) < 0
+	if negative {
+		i = i.MulSaturating(-1)
+	}
+	if j, err := i.JustifyInterval(); err == nil {
+		i = j
+	}
+
+	tmp := mathhelper.PowInt64(10, int64(i.precision))
+	v = [humanUnitsCount]int64{
+		int64(i.NormalYears()), int64(i.NormalMonths()), int64(i.Days),
+		i.NormalHours(), int64(i.NormalMinutes()), int64(i.NormalSeconds()),
+	}
+	frac := mathhelper.AbsInt64(i.SomeSeconds % tmp)
+	for u := range v {
+		v[u] = mathhelper.AbsInt64(v[u])
+	}
+
+	first := 0
+	for first < humanUnitsCount && v[first] == 0 {
+		first++
+	}
+	if first == humanUnitsCount {
+		lessThanSecond = frac != 0
+		if lessThanSecond && opts.Round && 2*frac >= tmp {
+			v[humanSecond], lessThanSecond = 1, false
+		}
+		approximate = lessThanSecond || frac != 0
+		return
+	}
+
+	last := humanUnitsCount - 1
+	if opts.Units > 0 && first+opts.Units-1 < last {
+		last = first + opts.Units - 1
+	}
+
+	// Rest of interval after the last printed unit (in seconds, fraction of second is used only if the last unit is second).
+	var rest int64
+	for u := last + 1; u < humanUnitsCount; u++ {
+		rest += v[u] * humanUnitSeconds[u]
+		v[u] = 0
+	}
+	approximate = rest != 0 || frac != 0
+
+	if opts.Round {
+		if (last == humanSecond && 2*frac >= tmp) || (last != humanSecond && 2*rest >= humanUnitSeconds[last]) {
+			v[last]++
+			for u := last; u > humanYear && v[u] == humanUnitSizes[u]; u-- {
+				v[u] = 0
+				v[u-1]++
+			}
+		}
+	}
+
+	return
+}
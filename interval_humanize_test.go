@@ -0,0 +1,139 @@
+package timehelper
+
+import "testing"
+
+func TestHumanize(t *testing.T) {
+	type testElement struct {
+		i    Interval
+		opts HumanizeOptions
+		s    string
+	}
+
+	test := []testElement{
+		// 0
+		{
+			i: Interval{27, 4, 0, SecondPrecision},
+			s: "2 years, 3 months and 4 days",
+		},
+
+		// 1
+		{
+			i:    Interval{0, 0, 5*SecsInMin + 10, SecondPrecision},
+			opts: HumanizeOptions{Units: 1},
+			s:    "about 5 minutes",
+		},
+
+		// 2
+		{
+			i:    Interval{0, 0, -3 * SecsInHour, SecondPrecision},
+			opts: HumanizeOptions{Relative: true},
+			s:    "3 hours ago",
+		},
+
+		// 3
+		{
+			i:    Interval{0, 2, 0, SecondPrecision},
+			opts: HumanizeOptions{Relative: true},
+			s:    "in 2 days",
+		},
+
+		// 4
+		{
+			i:    Interval{0, 0, 2*SecsInHour + 40*SecsInMin, SecondPrecision},
+			opts: HumanizeOptions{Units: 1, Round: true},
+			s:    "about 3 hours",
+		},
+
+		// 5
+		{
+			i:    Interval{0, 0, 2*SecsInHour + 40*SecsInMin, SecondPrecision},
+			opts: HumanizeOptions{Units: 1},
+			s:    "about 2 hours",
+		},
+
+		// 6
+		{
+			i:    Interval{11, 20, 0, SecondPrecision},
+			opts: HumanizeOptions{Units: 1, Round: true},
+			s:    "about 1 year",
+		},
+
+		// 7
+		{
+			i:    Interval{12, 1, 5, SecondPrecision},
+			opts: HumanizeOptions{Units: 2},
+			s:    "about 1 year",
+		},
+
+		// 8
+		{
+			i: Interval{1, -1, 0, SecondPrecision},
+			s: "29 days",
+		},
+
+		// 9
+		{
+			i: Interval{0, 0, 25 * SecsInHour, SecondPrecision},
+			s: "1 day and 1 hour",
+		},
+
+		// 10
+		{
+			i:    Interval{0, 0, 0, NanosecondPrecision},
+			opts: HumanizeOptions{Relative: true},
+			s:    "now",
+		},
+
+		// 11
+		{
+			i: Interval{0, 0, 0, NanosecondPrecision},
+			s: "0 seconds",
+		},
+
+		// 12
+		{
+			i:    Interval{0, 0, -300, MillisecondPrecision},
+			opts: HumanizeOptions{Relative: true},
+			s:    "less than a second ago",
+		},
+
+		// 13
+		{
+			i:    Interval{0, 0, 1500, MillisecondPrecision},
+			opts: HumanizeOptions{Round: true},
+			s:    "about 2 seconds",
+		},
+
+		// 14
+		{
+			i:    Interval{0, 0, 59*SecsInMin + 50, SecondPrecision},
+			opts: HumanizeOptions{Units: 1, Round: true, Relative: true},
+			s:    "in about 1 hour",
+		},
+
+		// 15
+		{
+			i: Interval{0, 0, -3 * SecsInHour, SecondPrecision},
+			s: "minus 3 hours",
+		},
+
+		// 16
+		{
+			i:    Interval{0, -2, -5*SecsInMin - 10, SecondPrecision},
+			opts: HumanizeOptions{Units: 3},
+			s:    "about minus 2 days and 5 minutes",
+		},
+
+		// 17
+		{
+			i: Interval{0, 0, -300, MillisecondPrecision},
+			s: "minus less than a second",
+		},
+	}
+
+	for j, v := range test {
+		if s := v.i.Humanize(v.opts); s != v.s {
+			t.Errorf("Test-%v. Expected: %v, got: %v", j, v.s, s)
+		}
+	}
+}
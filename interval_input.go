@@ -0,0 +1,266 @@
+package timehelper
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"regexp"
+	"strings"
+)
+
+// inputUnit is a unit of value in unit-based interval input ("1.5 days", "5 mins" and so on).
+type inputUnit uint8
+
+const (
+	unitMicrosecond inputUnit = iota
+	unitMillisecond
+	unitSecond
+	unitMinute
+	unitHour
+	unitDay
+	unitWeek
+	unitMonth
+	unitYear
+	unitDecade
+	unitCentury
+	unitMillennium
+)
+
+// inputUnits maps unit names accepted by PostgreSQL interval input to units.
+var inputUnits = map[string]inputUnit{
+	"us": unitMicrosecond, "usec": unitMicrosecond, "usecs": unitMicrosecond, "useconds": unitMicrosecond, "microsecon": unitMicrosecond, "microsecond": unitMicrosecond, "microseconds": unitMicrosecond,
+	"ms": unitMillisecond, "msec": unitMillisecond, "msecs": unitMillisecond, "mseconds": unitMillisecond, "millisecon": unitMillisecond, "millisecond": unitMillisecond, "milliseconds": unitMillisecond,
+	"s": unitSecond, "sec": unitSecond, "secs": unitSecond, "second": unitSecond, "seconds": unitSecond,
+	"m": unitMinute, "min": unitMinute, "mins": unitMinute, "minute": unitMinute, "minutes": unitMinute,
+	"h": unitHour, "hr": unitHour, "hrs": unitHour, "hour": unitHour, "hours": unitHour,
+	"d": unitDay, "day": unitDay, "days": unitDay,
+	"w": unitWeek, "week": unitWeek, "weeks": unitWeek,
+	"mon": unitMonth, "mons": unitMonth, "month": unitMonth, "months": unitMonth,
+	"y": unitYear, "yr": unitYear, "yrs": unitYear, "year": unitYear, "years": unitYear,
+	"dec": unitDecade, "decs": unitDecade, "decade": unitDecade, "decades": unitDecade,
+	"c": unitCentury, "cent": unitCentury, "century": unitCentury, "centuries": unitCentury,
+	"mil": unitMillennium, "mils": unitMillennium, "millennium": unitMillennium, "millennia": unitMillennium,
+}
+
+// REs for parse tokens of PostgreSQL interval input.
+var (
+	reInputNumber    = regexp.MustCompile(`^([+-]?(?:[0-9]+(?:\.[0-9]*)?|\.[0-9]+))([a-z]*)$`)
+	reInputUnit      = regexp.MustCompile(`^[a-z]+$`)
+	reInputYearMonth = regexp.MustCompile(`^([+-])?([0-9]+)-([0-9]+)$`)
+	reInputTime      = regexp.MustCompile(`^([+-])?([0-9]+):([0-9]+)(?::([0-9]+))?(\.[0-9]*)?$`)
+)
+
+// fieldsAccumulator collects values of interval fields while parsing unit-based input.
+// Fractional values are spilled down to smaller fields the same way as PostgreSQL does:
+// fraction of years goes to months (rounded), fraction of months and weeks goes to days, fraction of days goes to seconds.
+// Values are stored exactly, rounding to precision is performed only once while building resulting Interval.
+type fieldsAccumulator struct {
+	months  big.Int
+	days    big.Int
+	seconds big.Rat
+}
+
+// add adds value v of unit u to accumulator.
+func (a *fieldsAccumulator) add(v *big.Rat, u inputUnit) {
+	whole := new(big.Int).Quo(v.Num(), v.Denom())
+	frac := new(big.Rat).Sub(v, new(big.Rat).SetInt(whole))
+
+	switch u {
+	case unitMicrosecond:
+		a.addSeconds(v, 1, MicrosecsInSec)
+	case unitMillisecond:
+		a.addSeconds(v, 1, MillisecsInSec)
+	case unitSecond:
+		a.addSeconds(v, 1, 1)
+	case unitMinute:
+		a.addSeconds(v, SecsInMin, 1)
+	case unitHour:
+		a.addSeconds(v, SecsInHour, 1)
+	case unitDay:
+		a.days.Add(&a.days, whole)
+		a.addSeconds(frac, SecsInDay, 1)
+	case unitWeek:
+		a.days.Add(&a.days, new(big.Int).Mul(whole, big.NewInt(7)))
+		a.addFractionalDays(frac, 7)
+	case unitMonth:
+		a.months.Add(&a.months, whole)
+		a.addFractionalDays(frac, DaysInMonth)
+	default:
+		months := int64(MonthsInYear)
+		switch u {
+		case unitDecade:
+			months *= 10
+		case unitCentury:
+			months *= 100
+		case unitMillennium:
+			months *= 1000
+		}
+		a.months.Add(&a.months, new(big.Int).Mul(whole, big.NewInt(months)))
+		a.months.Add(&a.months, roundRat(new(big.Rat).Mul(frac, new(big.Rat).SetInt64(months))))
+	}
+}
+
+// addSeconds adds v*mul/div seconds to accumulator.
+func (a *fieldsAccumulator) addSeconds(v *big.Rat, mul, div int64) {
+	a.seconds.Add(&a.seconds, new(big.Rat).Mul(v, big.NewRat(mul, div)))
+}
+
+// addFractionalDays adds frac*scale days to accumulator. Whole part goes to days and fraction part goes to seconds.
+func (a *fieldsAccumulator) addFractionalDays(frac *big.Rat, scale int64) {
+	d := new(big.Rat).Mul(frac, new(big.Rat).SetInt64(scale))
+	whole := new(big.Int).Quo(d.Num(), d.Denom())
+	a.days.Add(&a.days, whole)
+	a.addSeconds(d.Sub(d, new(big.Rat).SetInt(whole)), SecsInDay, 1)
+}
+
+// negate changes sign of all accumulated values.
+func (a *fieldsAccumulator) negate() {
+	a.months.Neg(&a.months)
+	a.days.Neg(&a.days)
+	a.seconds.Neg(&a.seconds)
+}
+
+// interval builds Interval with precision p from accumulated values.
+// Seconds are rounded to precision p. OverflowError with operation op returned if any field does not fit in Interval.
+func (a *fieldsAccumulator) interval(p uint8, op string) (i Interval, err error) {
+	i.precision = p
+
+	someSeconds := roundRat(new(big.Rat).Mul(&a.seconds, new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(p)), nil))))
+	if !a.months.IsInt64() || a.months.Int64() < math.MinInt32 || a.months.Int64() > math.MaxInt32 ||
+		!a.days.IsInt64() || a.days.Int64() < math.MinInt32 || a.days.Int64() > math.MaxInt32 ||
+		!someSeconds.IsInt64() {
+		err = &OverflowError{Op: op}
+		return
+	}
+
+	i.Months = int32(a.months.Int64())
+	i.Days = int32(a.days.Int64())
+	i.SomeSeconds = someSeconds.Int64()
+	return
+}
+
+// roundRat rounds r to integer. Round rule: 0.4=>0 ; 0.5=>1 ; 0.6=>1 ; -0.4=>0 ; -0.5=>-1 ; -0.6=>-1
+func roundRat(r *big.Rat) *big.Int {
+	q, m := new(big.Int).QuoRem(r.Num(), r.Denom(), new(big.Int))
+	if m.Abs(m).Lsh(m, 1).Cmp(r.Denom()) >= 0 {
+		q.Add(q, big.NewInt(int64(r.Num().Sign())))
+	}
+	return q
+}
+
+// ParseInput parses incoming string using (almost) full PostgreSQL interval input grammar and extract interval with requested precision p.
+// Unlike Parse it accepts:
+// 	unit names in any order, singular, plural and abbreviated form (from microseconds up to millenniums): "1 yr 3 weeks 2 hours", "5 mins", "1 decade";
+// 	fractional values: "1.5 days", "0.5 months";
+// 	optional "@" prefix and "ago" suffix (which negates all fields): "@ 3 days ago";
+// 	time fields "4:05", "4:05:06.789" and year-month fields "1-2";
+// 	number without unit before time field (days) or at the end (seconds).
+// Fractional values are spilled down to smaller fields the same way PostgreSQL does:
+// fraction of years goes to months (rounded to whole months), fraction of months and weeks goes to days and seconds, fraction of days goes to seconds.
+// So "1.5 mons" is "1 mons 15 days" and "1.5 days" is "1 days 12:00:00".
+// Input is case insensitive. If value does not fit in Interval when OverflowError returned.
+func ParseInput(s string, p uint8) (i Interval, err error) {
+	if p > maxPrecision {
+		p = maxPrecision
+	}
+	i.precision = p
+
+	fields := strings.Fields(strings.ToLower(s))
+	if len(fields) > 0 && strings.HasPrefix(fields[0], "@") {
+		if fields[0] = fields[0][1:]; fields[0] == "" {
+			fields = fields[1:]
+		}
+	}
+	ago := len(fields) > 0 && fields[len(fields)-1] == "ago"
+	if ago {
+		fields = fields[:len(fields)-1]
+	}
+	if len(fields) == 0 {
+		err = &ParseError{Input: s, Err: ErrSyntax}
+		return
+	}
+
+	var a fieldsAccumulator
+	for j := 0; j < len(fields); j++ {
+		f := fields[j]
+
+		if parts := reInputTime.FindStringSubmatch(f); parts != nil {
+			a.addSeconds(parseInputTime(parts), 1, 1)
+			continue
+		}
+
+		if parts := reInputYearMonth.FindStringSubmatch(f); parts != nil {
+			v, _ := new(big.Rat).SetString(parts[1] + parts[2])
+			a.add(v, unitYear)
+			v, _ = new(big.Rat).SetString(parts[1] + parts[3])
+			a.add(v, unitMonth)
+			continue
+		}
+
+		parts := reInputNumber.FindStringSubmatch(f)
+		if parts == nil {
+			err = &ParseError{Input: s, Err: ErrSyntax}
+			return
+		}
+		v, ok := new(big.Rat).SetString(parts[1])
+		if !ok {
+			err = &ParseError{Input: s, Err: ErrSyntax}
+			return
+		}
+
+		unitName := parts[2]
+		if unitName == "" && j+1 < len(fields) && reInputUnit.MatchString(fields[j+1]) {
+			j++
+			unitName = fields[j]
+		}
+
+		var u inputUnit
+		switch {
+		case unitName != "":
+			if u, ok = inputUnits[unitName]; !ok {
+				err = &ParseError{Input: s, Err: fmt.Errorf("%w: unknown unit %q", ErrSyntax, unitName)}
+				return
+			}
+		case j+1 < len(fields) && reInputTime.MatchString(fields[j+1]):
+			u = unitDay
+		case j+1 == len(fields):
+			u = unitSecond
+		default:
+			err = &ParseError{Input: s, Err: ErrSyntax}
+			return
+		}
+		a.add(v, u)
+	}
+
+	if ago {
+		a.negate()
+	}
+
+	return a.interval(p, "ParseInput")
+}
+
+// parseInputTime returns number of seconds in time field parsed by reInputTime.
+// Time field is "h:m", "h:m:s" or "h:m:s.f". Two-component field with fraction "m:s.f" is treated as minutes and seconds.
+func parseInputTime(parts []string) *big.Rat {
+	h, _ := new(big.Rat).SetString(parts[2])
+	m, _ := new(big.Rat).SetString(parts[3])
+	s := new(big.Rat)
+	if parts[4] != "" {
+		s.SetString(parts[4])
+	} else if parts[5] != "" {
+		// "m:s.f"
+		h, m, s = new(big.Rat), h, m
+	}
+	if parts[5] != "" && parts[5] != "." {
+		f, _ := new(big.Rat).SetString("0" + parts[5])
+		s.Add(s, f)
+	}
+
+	r := new(big.Rat).Mul(h, big.NewRat(SecsInHour, 1))
+	r.Add(r, m.Mul(m, big.NewRat(SecsInMin, 1)))
+	r.Add(r, s)
+	if parts[1] == "-" {
+		r.Neg(r)
+	}
+	return r
+}
@@ -0,0 +1,164 @@
+package timehelper
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseInput(t *testing.T) {
+	type testElement struct {
+		s   string
+		i   Interval
+		err bool
+	}
+
+	test := []testElement{
+		// 0
+		{
+			s: "-1 year 2 mons -3 days 04:05:06.789",
+			i: Interval{-10, -3, 14706789 * 1e3, MicrosecondPrecision},
+		},
+
+		// 1
+		{
+			s: "1 yr 3 weeks 2 hours",
+			i: Interval{12, 21, 7200 * 1e6, MicrosecondPrecision},
+		},
+
+		// 2
+		{
+			s: "5 mins",
+			i: Interval{0, 0, 300 * 1e6, MicrosecondPrecision},
+		},
+
+		// 3
+		{
+			s: "1 decade 1 century 1 millennium",
+			i: Interval{13320, 0, 0, MicrosecondPrecision},
+		},
+
+		// 4
+		{
+			s: "1.5 days",
+			i: Interval{0, 1, 43200 * 1e6, MicrosecondPrecision},
+		},
+
+		// 5
+		{
+			s: "0.5 months",
+			i: Interval{0, 15, 0, MicrosecondPrecision},
+		},
+
+		// 6
+		{
+			s: "1.01 mons",
+			i: Interval{1, 0, 25920 * 1e6, MicrosecondPrecision},
+		},
+
+		// 7
+		{
+			s: "1.5 years",
+			i: Interval{18, 0, 0, MicrosecondPrecision},
+		},
+
+		// 8
+		{
+			s: "-1.5 weeks",
+			i: Interval{0, -10, -43200 * 1e6, MicrosecondPrecision},
+		},
+
+		// 9
+		{
+			s: "@ 1 year 2 mons -3 days ago",
+			i: Interval{-14, 3, 0, MicrosecondPrecision},
+		},
+
+		// 10
+		{
+			s: "4:05",
+			i: Interval{0, 0, 14700 * 1e6, MicrosecondPrecision},
+		},
+
+		// 11
+		{
+			s: "1:02.5",
+			i: Interval{0, 0, 62500 * 1e3, MicrosecondPrecision},
+		},
+
+		// 12
+		{
+			s: "1 2:03:04",
+			i: Interval{0, 1, 7384 * 1e6, MicrosecondPrecision},
+		},
+
+		// 13
+		{
+			s: "1-2",
+			i: Interval{14, 0, 0, MicrosecondPrecision},
+		},
+
+		// 14
+		{
+			s: "1.5",
+			i: Interval{0, 0, 1500 * 1e3, MicrosecondPrecision},
+		},
+
+		// 15
+		{
+			s: "1DAY 2Hours 3 msec 4 us",
+			i: Interval{0, 1, 7200003004, MicrosecondPrecision},
+		},
+
+		// 16
+		{
+			s: "0.0000005 secs",
+			i: Interval{0, 0, 1, MicrosecondPrecision},
+		},
+
+		// 17
+		{
+			s:   "",
+			err: true,
+		},
+
+		// 18
+		{
+			s:   "1 fortnight",
+			err: true,
+		},
+
+		// 19
+		{
+			s:   "1 2 days",
+			err: true,
+		},
+
+		// 20
+		{
+			s:   "ago",
+			err: true,
+		},
+
+		// 21
+		{
+			s:   "3000000000 days",
+			err: true,
+		},
+	}
+
+	for j, v := range test {
+		i, err := ParseInput(v.s, MicrosecondPrecision)
+		if (err != nil) != v.err {
+			t.Errorf("Test-%v, got error: %s", j, err)
+		}
+		if !v.err && err == nil && i != v.i {
+			t.Errorf("Test-%v. Intervals not equal.\nExpected:\n%#v\ngot:\n%#v", j, v.i, i)
+		}
+	}
+
+	for j, s := range []string{"2147483648 days", "3000000000 days", "200000000 years", "9999999999999 secs"} {
+		if _, err := ParseInput(s, MicrosecondPrecision); !errors.Is(err, ErrOverflow) {
+			t.Errorf("Test-%v. Expected overflow for %v, got: %v", j, s, err)
+		}
+	}
+}
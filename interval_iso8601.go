@@ -0,0 +1,119 @@
+package timehelper
+
+import (
+	"github.com/apaxa-io/mathhelper"
+	"github.com/apaxa-io/strconvhelper"
+	"math/big"
+	"regexp"
+)
+
+// RE for parse interval in ISO 8601 duration format (with PostgreSQL extension: each field may be signed).
+// https://en.wikipedia.org/wiki/ISO_8601#Durations
+var reISO8601 = regexp.MustCompile(`^([+-])?P(?:([+-]?[0-9]+)Y)?(?:([+-]?[0-9]+)M)?(?:([+-]?[0-9]+)W)?(?:([+-]?[0-9]+)D)?(?:T(?:([+-]?[0-9]+)H)?(?:([+-]?[0-9]+)M)?(?:([+-])?([0-9]+)(?:[.,]([0-9]+))?S)?)?$`)
+
+// ParseISO8601 parses incoming string in ISO 8601 duration format and extract interval with requested precision p.
+// Years and months are stored in Months, weeks and days are stored in Days, hours, minutes and seconds are stored in SomeSeconds.
+// Leading sign applies to all fields. Additionally each field may be signed independently (as PostgreSQL iso_8601 IntervalStyle outputs).
+// Only seconds may have fraction part, extra digits of fraction are rounded as in Parse.
+// If value does not fit in Interval when OverflowError returned.
+// Examples:
+// 	P1Y2M3DT4H5M6.789S
+// 	PT0S
+// 	-P1D
+// 	P2W
+// 	P-1Y-2M3DT-4H-5M-6S
+func ParseISO8601(s string, p uint8) (i Interval, err error) {
+	if p > maxPrecision {
+		i.precision = maxPrecision
+	} else {
+		i.precision = p
+	}
+
+	parts := reISO8601.FindStringSubmatch(s)
+	if parts == nil || len(parts) != 11 || s[len(s)-1] == 'P' || s[len(s)-1] == 'T' {
+		err = &ParseError{Input: s, Err: ErrSyntax}
+		return
+	}
+
+	var v [7]big.Int // years, months, weeks, days, hours, minutes, seconds
+	for j := range v[:6] {
+		if parts[j+2] != "" {
+			v[j].SetString(parts[j+2], 10)
+		}
+	}
+	if parts[9] != "" {
+		v[6].SetString(parts[8]+parts[9], 10)
+	}
+
+	var b bigInterval
+	b.months.Mul(&v[0], big.NewInt(MonthsInYear))
+	b.months.Add(&b.months, &v[1])
+	b.days.Mul(&v[2], big.NewInt(7))
+	b.days.Add(&b.days, &v[3])
+	b.someSeconds.Mul(&v[4], big.NewInt(MinsInHour))
+	b.someSeconds.Add(&b.someSeconds, &v[5])
+	b.someSeconds.Mul(&b.someSeconds, big.NewInt(SecsInMin))
+	b.someSeconds.Add(&b.someSeconds, &v[6])
+	b.someSeconds.Mul(&b.someSeconds, pow10Big(i.precision))
+	if parts[10] != "" {
+		var frac int64
+		if frac, err = parseFraction(parts[10], i.precision); err != nil {
+			return
+		}
+		if parts[8] == "-" {
+			frac = -frac
+		}
+		b.someSeconds.Add(&b.someSeconds, big.NewInt(frac))
+	}
+
+	if parts[1] == "-" {
+		b.months.Neg(&b.months)
+		b.days.Neg(&b.days)
+		b.someSeconds.Neg(&b.someSeconds)
+	}
+
+	var ok bool
+	if i, ok = b.interval(i.precision); !ok {
+		err = &OverflowError{Op: "ParseISO8601"}
+	}
+	return
+}
+
+// ISO8601 returns string representation of interval in ISO 8601 duration format.
+// Output is the same as PostgreSQL produce with iso_8601 IntervalStyle: each non-zero field is signed independently and zero interval is "PT0S".
+// Output can be parsed by ParseISO8601.
+func (i Interval) ISO8601() string {
+	if i.Months == 0 && i.Days == 0 && i.SomeSeconds == 0 {
+		return "PT0S"
+	}
+
+	str := "P"
+	if y := i.NormalYears(); y != 0 {
+		str += strconvhelper.FormatInt32(y) + "Y"
+	}
+	if mon := i.NormalMonths(); mon != 0 {
+		str += strconvhelper.FormatInt32(mon) + "M"
+	}
+	if i.Days != 0 {
+		str += strconvhelper.FormatInt32(i.Days) + "D"
+	}
+
+	if i.SomeSeconds != 0 {
+		h, m, s, f := i.timeParts()
+		str += "T"
+		if h != 0 {
+			str += strconvhelper.FormatInt64(h) + "H"
+		}
+		if m != 0 {
+			str += strconvhelper.FormatInt64(m) + "M"
+		}
+		if s != 0 || f != 0 {
+			if s < 0 || f < 0 {
+				str += "-"
+			}
+			str += strconvhelper.FormatInt64(mathhelper.AbsInt64(s)) + formatFraction(f, i.precision) + "S"
+		}
+	}
+
+	return str
+}
@@ -0,0 +1,223 @@
+package timehelper
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestParseISO8601(t *testing.T) {
+	type testElement struct {
+		s   string
+		p   uint8
+		i   Interval
+		err bool
+	}
+
+	test := []testElement{
+		// 0
+		{
+			s: "P1Y2M3DT4H5M6.789S",
+			p: NanosecondPrecision,
+			i: Interval{14, 3, 14706789 * 1e6, NanosecondPrecision},
+		},
+
+		// 1
+		{
+			s: "PT0S",
+			p: NanosecondPrecision,
+			i: Interval{0, 0, 0, NanosecondPrecision},
+		},
+
+		// 2
+		{
+			s: "-P1D",
+			p: NanosecondPrecision,
+			i: Interval{0, -1, 0, NanosecondPrecision},
+		},
+
+		// 3
+		{
+			s: "P2W",
+			p: NanosecondPrecision,
+			i: Interval{0, 14, 0, NanosecondPrecision},
+		},
+
+		// 4
+		{
+			s: "P-1Y-2M3DT-4H-5M-6S",
+			p: NanosecondPrecision,
+			i: Interval{-14, 3, -14706 * 1e9, NanosecondPrecision},
+		},
+
+		// 5
+		{
+			s: "-P1Y2M3DT4H5M6,5S",
+			p: MillisecondPrecision,
+			i: Interval{-14, -3, -14706500, MillisecondPrecision},
+		},
+
+		// 6
+		{
+			s: "PT1.23456789S",
+			p: MicrosecondPrecision,
+			i: Interval{0, 0, 1234568, MicrosecondPrecision},
+		},
+
+		// 7
+		{
+			s: "PT-0.5S",
+			p: SecondPrecision,
+			i: Interval{0, 0, -1, SecondPrecision},
+		},
+
+		// 8
+		{
+			s: "PT36H",
+			p: MicrosecondPrecision,
+			i: Interval{0, 0, 36 * 3600 * 1e6, MicrosecondPrecision},
+		},
+
+		// 9
+		{
+			s:   "P",
+			err: true,
+		},
+
+		// 10
+		{
+			s:   "P1DT",
+			err: true,
+		},
+
+		// 11
+		{
+			s:   "P1.5D",
+			err: true,
+		},
+
+		// 12
+		{
+			s:   "1 day",
+			err: true,
+		},
+
+		// 13
+		{
+			s:   "",
+			err: true,
+		},
+
+		// 14
+		{
+			s: "-P178956970Y8M",
+			p: NanosecondPrecision,
+			i: Interval{math.MinInt32, 0, 0, NanosecondPrecision},
+		},
+
+		// 15
+		{
+			s:   "P200000000Y",
+			p:   NanosecondPrecision,
+			err: true,
+		},
+
+		// 16
+		{
+			s:   "PT9999999H",
+			p:   PicosecondPrecision,
+			err: true,
+		},
+
+		// 17
+		{
+			s:   "P3000000000D",
+			p:   NanosecondPrecision,
+			err: true,
+		},
+
+		// 18
+		{
+			s:   "PT99999999999999999999S",
+			p:   SecondPrecision,
+			err: true,
+		},
+	}
+
+	for j, v := range test {
+		i, err := ParseISO8601(v.s, v.p)
+		if (err != nil) != v.err {
+			t.Errorf("Test-%v, got error: %s", j, err)
+		}
+		if !v.err && err == nil && i != v.i {
+			t.Errorf("Test-%v. Intervals not equal.\nExpected:\n%#v\ngot:\n%#v", j, v.i, i)
+		}
+	}
+
+	var overflowError *OverflowError
+	if _, err := ParseISO8601("P200000000Y", NanosecondPrecision); !errors.As(err, &overflowError) {
+		t.Errorf("Expected OverflowError, got: %v", err)
+	}
+}
+
+func TestISO8601(t *testing.T) {
+	type testElement struct {
+		s string
+		i Interval
+	}
+
+	test := []testElement{
+		// 0
+		{
+			s: "P1Y2M3DT4H5M6.789S",
+			i: Interval{14, 3, 14706789 * 1e6, NanosecondPrecision},
+		},
+
+		// 1
+		{
+			s: "PT0S",
+			i: Interval{0, 0, 0, NanosecondPrecision},
+		},
+
+		// 2
+		{
+			s: "P-1Y-2M3DT-4H-5M-6S",
+			i: Interval{-14, 3, -14706 * 1e9, NanosecondPrecision},
+		},
+
+		// 3
+		{
+			s: "P1M",
+			i: Interval{1, 0, 0, MicrosecondPrecision},
+		},
+
+		// 4
+		{
+			s: "PT-0.5S",
+			i: Interval{0, 0, -500, MillisecondPrecision},
+		},
+
+		// 5
+		{
+			s: "P83Y4M1000D",
+			i: Interval{1000, 1000, 0, SecondPrecision},
+		},
+
+		// 6
+		{
+			s: "PT34H57M18S",
+			i: Interval{0, 0, 125838, SecondPrecision},
+		},
+	}
+
+	for j, v := range test {
+		s := v.i.ISO8601()
+		if s != v.s {
+			t.Errorf("Test-%v. Strings not equal.\nExpected:\n%s\ngot:\n%s", j, v.s, s)
+		}
+		i, err := ParseISO8601(s, v.i.precision)
+		if err != nil || i != v.i {
+			t.Errorf("Test-%v. Round trip failed.\nExpected:\n%#v\ngot:\n%#v (error: %v)", j, v.i, i, err)
+		}
+	}
+}
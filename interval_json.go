@@ -0,0 +1,109 @@
+package timehelper
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strconv"
+)
+
+// JSONFormat is a form of Interval in JSON.
+type JSONFormat uint8
+
+const (
+	// JSONString is a JSON string with the same text as Interval.String returns: "1 year 2 mons 3 days 04:05:06".
+	JSONString JSONFormat = iota
+	// JSONISO8601 is a JSON string with ISO 8601 duration: "P1Y2M3DT4H5M6S".
+	JSONISO8601
+	// JSONObject is a JSON object with all fields of Interval including precision: {"months":14,"days":3,"seconds":14706,"precision":0}.
+	// Seconds in this form are SomeSeconds, i.e. number of units of precision.
+	JSONObject
+)
+
+// JSONStringInterval is an Interval which is marshalled to JSON in JSONString form.
+// It may be used as a field type to choose form per value: struct{ Timeout JSONStringInterval }.
+type JSONStringInterval Interval
+
+// JSONISO8601Interval is an Interval which is marshalled to JSON in JSONISO8601 form.
+type JSONISO8601Interval Interval
+
+// intervalJSON is a JSONObject form of Interval.
+type intervalJSON struct {
+	Months    int32 `json:"months"`
+	Days      int32 `json:"days"`
+	Seconds   int64 `json:"seconds"`
+	Precision uint8 `json:"precision"`
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// Interval is marshalled in JSONObject form, so precision is kept.
+// Use JSONStringInterval or JSONISO8601Interval (or MarshalJSONFormat) for other forms.
+func (i Interval) MarshalJSON() ([]byte, error) {
+	return i.MarshalJSONFormat(JSONObject)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (i JSONStringInterval) MarshalJSON() ([]byte, error) {
+	return Interval(i).MarshalJSONFormat(JSONString)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It accepts any form as Interval.UnmarshalJSON.
+func (i *JSONStringInterval) UnmarshalJSON(data []byte) error {
+	return (*Interval)(i).UnmarshalJSON(data)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (i JSONISO8601Interval) MarshalJSON() ([]byte, error) {
+	return Interval(i).MarshalJSONFormat(JSONISO8601)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface. It accepts any form as Interval.UnmarshalJSON.
+func (i *JSONISO8601Interval) UnmarshalJSON(data []byte) error {
+	return (*Interval)(i).UnmarshalJSON(data)
+}
+
+// MarshalJSONFormat returns JSON encoding of Interval in given form.
+func (i Interval) MarshalJSONFormat(f JSONFormat) ([]byte, error) {
+	switch f {
+	case JSONString:
+		return json.Marshal(i.String())
+	case JSONISO8601:
+		return json.Marshal(i.ISO8601())
+	case JSONObject:
+		return json.Marshal(intervalJSON{Months: i.Months, Days: i.Days, Seconds: i.SomeSeconds, Precision: i.precision})
+	default:
+		return nil, errors.New("Unknown interval JSON format " + strconv.Itoa(int(f)))
+	}
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It accepts any JSONFormat form.
+// String is parsed as by UnmarshalText: it may be in any PostgreSQL IntervalStyle (see Style) or in Go duration syntax
+// and precision of Interval is kept (DefaultTextPrecision is used if it is zero).
+// Object sets precision from it, missing fields are treated as zero.
+// JSON null does not change Interval.
+func (i *Interval) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	switch {
+	case bytes.Equal(data, []byte("null")):
+		return nil
+	case len(data) > 0 && data[0] == '"':
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		return i.UnmarshalText([]byte(s))
+	case len(data) > 0 && data[0] == '{':
+		var v intervalJSON
+		if err := json.Unmarshal(data, &v); err != nil {
+			return err
+		}
+		if v.Precision > maxPrecision {
+			return errors.New("Invalid interval precision " + strconv.Itoa(int(v.Precision)))
+		}
+		*i = Interval{Months: v.Months, Days: v.Days, SomeSeconds: v.Seconds, precision: v.Precision}
+		return nil
+	default:
+		return errors.New("Unable to unmarshal interval from JSON " + string(data))
+	}
+}
@@ -0,0 +1,191 @@
+package timehelper
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestMarshalJSONFormat(t *testing.T) {
+	type testElement struct {
+		i   Interval
+		f   JSONFormat
+		s   string
+		err bool
+	}
+
+	test := []testElement{
+		// 0
+		{
+			i: Interval{14, 3, 14706789 * 1e3, MicrosecondPrecision},
+			f: JSONString,
+			s: `"1 year 2 mons 3 days 04:05:06.789"`,
+		},
+
+		// 1
+		{
+			i: Interval{14, 3, 14706789 * 1e3, MicrosecondPrecision},
+			f: JSONISO8601,
+			s: `"P1Y2M3DT4H5M6.789S"`,
+		},
+
+		// 2
+		{
+			i: Interval{14, 3, 14706789 * 1e3, MicrosecondPrecision},
+			f: JSONObject,
+			s: `{"months":14,"days":3,"seconds":14706789000,"precision":6}`,
+		},
+
+		// 3
+		{
+			i:   Interval{14, 3, 0, MicrosecondPrecision},
+			f:   JSONFormat(100),
+			err: true,
+		},
+	}
+
+	for j, v := range test {
+		b, err := v.i.MarshalJSONFormat(v.f)
+		if (err != nil) != v.err {
+			t.Errorf("Test-%v. Unexpected error: %v", j, err)
+		} else if !v.err && string(b) != v.s {
+			t.Errorf("Test-%v. Expected: %v, got: %v", j, v.s, string(b))
+		}
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	type testElement struct {
+		s   string
+		i   Interval
+		err bool
+	}
+
+	test := []testElement{
+		// 0
+		{
+			s: `"1 year 2 mons 3 days 04:05:06.789"`,
+			i: Interval{14, 3, 14706789 * 1e6, NanosecondPrecision},
+		},
+
+		// 1
+		{
+			s: `"P1Y2M3DT4H5M6.789S"`,
+			i: Interval{14, 3, 14706789 * 1e6, NanosecondPrecision},
+		},
+
+		// 2
+		{
+			s: `"@ 1 year 2 mons ago"`,
+			i: Interval{-14, 0, 0, NanosecondPrecision},
+		},
+
+		// 3
+		{
+			s: `"-1-2"`,
+			i: Interval{-14, 0, 0, NanosecondPrecision},
+		},
+
+		// 4
+		{
+			s: ` {"months":14,"days":3,"seconds":14706789000,"precision":6}`,
+			i: Interval{14, 3, 14706789 * 1e3, MicrosecondPrecision},
+		},
+
+		// 5
+		{
+			s: `{"days":3}`,
+			i: Interval{0, 3, 0, SecondPrecision},
+		},
+
+		// 6
+		{
+			s:   `{"months":1,"precision":13}`,
+			err: true,
+		},
+
+		// 7
+		{
+			s:   `"1 fortnight"`,
+			err: true,
+		},
+
+		// 8
+		{
+			s:   `14`,
+			err: true,
+		},
+
+		// 9
+		{
+			s:   `"P200000000Y"`,
+			err: true,
+		},
+
+		// 10
+		{
+			s: `"1h30m"`,
+			i: Interval{0, 0, 90 * SecsInMin * NanosecsInSec, NanosecondPrecision},
+		},
+	}
+
+	for j, v := range test {
+		var i Interval
+		err := json.Unmarshal([]byte(v.s), &i)
+		if (err != nil) != v.err {
+			t.Errorf("Test-%v. Unexpected error: %v", j, err)
+		} else if !v.err && (i != v.i) {
+			t.Errorf("Test-%v. Expected: %v, got: %v", j, v.i, i)
+		}
+	}
+
+	// String is parsed with precision of Interval as by UnmarshalText.
+	i := NewInterval(MillisecondPrecision)
+	if err := json.Unmarshal([]byte(`"00:00:01.23456"`), &i); err != nil || i != (Interval{0, 0, 1235, MillisecondPrecision}) {
+		t.Errorf("Expected: %v, got: %v (error: %v)", Interval{0, 0, 1235, MillisecondPrecision}, i, err)
+	}
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	type container struct {
+		I *Interval           `json:"i"`
+		S JSONStringInterval  `json:"s"`
+		P JSONISO8601Interval `json:"p"`
+	}
+
+	test := []Interval{
+		Interval{-14, 3, -14706789, MillisecondPrecision},
+		Interval{0, 0, 1000, PicosecondPrecision},
+		NewPgInterval(),
+	}
+
+	for j, v := range test {
+		v := v
+		b, err := json.Marshal(container{&v, JSONStringInterval(v), JSONISO8601Interval(v)})
+		if err != nil {
+			t.Errorf("Test-%v. Unexpected error: %v", j, err)
+			continue
+		}
+		var c container
+		if err = json.Unmarshal(b, &c); err != nil {
+			t.Errorf("Test-%v. Unexpected error: %v", j, err)
+			continue
+		}
+		// Default form keeps precision, string forms keep value only.
+		if *c.I != v {
+			t.Errorf("Test-%v. Expected: %#v, got: %#v (%v)", j, v, *c.I, string(b))
+		}
+		if !Interval(c.S).Equal(v) || !Interval(c.P).Equal(v) {
+			t.Errorf("Test-%v. Expected: %v, got: %v and %v (%v)", j, v, Interval(c.S), Interval(c.P), string(b))
+		}
+	}
+
+	b, err := json.Marshal(container{I: &Interval{14, 0, 0, SecondPrecision}, S: JSONStringInterval{14, 0, 0, SecondPrecision}, P: JSONISO8601Interval{14, 0, 0, SecondPrecision}})
+	if expected := `{"i":{"months":14,"days":0,"seconds":0,"precision":0},"s":"1 year 2 mons","p":"P1Y2M"}`; err != nil || string(b) != expected {
+		t.Errorf("Expected: %v, got: %v (error: %v)", expected, string(b), err)
+	}
+
+	var c container
+	if err := json.Unmarshal([]byte(`{"i":null}`), &c); err != nil || c.I != nil {
+		t.Errorf("Expected nil interval, got: %v (error: %v)", c.I, err)
+	}
+}
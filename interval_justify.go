@@ -0,0 +1,111 @@
+package timehelper
+
+import (
+	"github.com/apaxa-io/mathhelper"
+	"math"
+)
+
+// JustifyHours moves whole 24-hour periods from seconds part to days part (as PostgreSQL justify_hours does).
+// After that if days and seconds parts have different signs one day is moved back to seconds part, so both parts have the same sign.
+// Examples:
+// 	27:00:00 => 1 days 03:00:00
+// 	1 days -01:00:00 => 23:00:00
+// 	1 mons -1 days => 1 mons -1 days (nothing to justify)
+// OverflowError returned if days part of result does not fit in Interval.
+func (i Interval) JustifyHours() (Interval, error) {
+	days, secs := justifyHours(int64(i.Days), i.SomeSeconds, i.precision)
+	if days < math.MinInt32 || days > math.MaxInt32 {
+		return NewInterval(i.precision), &OverflowError{Op: "JustifyHours"}
+	}
+	i.Days, i.SomeSeconds = int32(days), secs
+	return i, nil
+}
+
+// JustifyDays moves whole 30-day periods from days part to months part (as PostgreSQL justify_days does).
+// After that if months and days parts have different signs one month is moved back to days part, so both parts have the same sign.
+// Seconds part is not changed.
+// Examples:
+// 	35 days => 1 mons 5 days
+// 	-35 days => -1 mons -5 days
+// 	1 mons -1 days => 29 days
+// OverflowError returned if months part of result does not fit in Interval.
+func (i Interval) JustifyDays() (Interval, error) {
+	months, days := justifyDays(int64(i.Months), int64(i.Days))
+	if months < math.MinInt32 || months > math.MaxInt32 {
+		return NewInterval(i.precision), &OverflowError{Op: "JustifyDays"}
+	}
+	i.Months, i.Days = int32(months), int32(days)
+	return i, nil
+}
+
+// JustifyInterval is a combination of JustifyHours and JustifyDays with additional sign adjustment (as PostgreSQL justify_interval does):
+// all non-zero parts of result have the same sign.
+// Examples:
+// 	1 mons -01:00:00 => 29 days 23:00:00
+// 	-1 mons 30 days 48:00:00 => 2 days
+// OverflowError returned if months or days part of result does not fit in Interval.
+func (i Interval) JustifyInterval() (Interval, error) {
+	months, days, secs := int64(i.Months), int64(i.Days), i.SomeSeconds
+	day := SecsInDay * mathhelper.PowInt64(10, int64(i.precision))
+
+	// Pre-justify days to avoid overflow of days part if days and seconds have the same sign.
+	if (days > 0 && secs > 0) || (days < 0 && secs < 0) {
+		months += days / DaysInMonth
+		days %= DaysInMonth
+	}
+
+	days += secs / day
+	secs %= day
+	months += days / DaysInMonth
+	days %= DaysInMonth
+
+	if months > 0 && (days < 0 || (days == 0 && secs < 0)) {
+		days += DaysInMonth
+		months--
+	} else if months < 0 && (days > 0 || (days == 0 && secs > 0)) {
+		days -= DaysInMonth
+		months++
+	}
+
+	if days > 0 && secs < 0 {
+		secs += day
+		days--
+	} else if days < 0 && secs > 0 {
+		secs -= day
+		days++
+	}
+
+	if months < math.MinInt32 || months > math.MaxInt32 || days < math.MinInt32 || days > math.MaxInt32 {
+		return NewInterval(i.precision), &OverflowError{Op: "JustifyInterval"}
+	}
+	return Interval{Months: int32(months), Days: int32(days), SomeSeconds: secs, precision: i.precision}, nil
+}
+
+// justifyHours implements JustifyHours for days and seconds (in units of precision p) parts.
+func justifyHours(days, secs int64, p uint8) (int64, int64) {
+	day := SecsInDay * mathhelper.PowInt64(10, int64(p))
+	days += secs / day
+	secs %= day
+	if days > 0 && secs < 0 {
+		secs += day
+		days--
+	} else if days < 0 && secs > 0 {
+		secs -= day
+		days++
+	}
+	return days, secs
+}
+
+// justifyDays implements JustifyDays for months and days parts.
+func justifyDays(months, days int64) (int64, int64) {
+	months += days / DaysInMonth
+	days %= DaysInMonth
+	if months > 0 && days < 0 {
+		days += DaysInMonth
+		months--
+	} else if months < 0 && days > 0 {
+		days -= DaysInMonth
+		months++
+	}
+	return months, days
+}
@@ -0,0 +1,118 @@
+package timehelper
+
+import (
+	"math"
+	"testing"
+)
+
+func TestJustify(t *testing.T) {
+	type testElement struct {
+		i         Interval
+		hours     Interval
+		days      Interval
+		interval  Interval
+		hoursErr  bool
+		daysErr   bool
+		intervErr bool
+	}
+
+	test := []testElement{
+		// 0
+		{
+			i:        Interval{0, 0, 27 * SecsInHour, SecondPrecision},
+			hours:    Interval{0, 1, 3 * SecsInHour, SecondPrecision},
+			days:     Interval{0, 0, 27 * SecsInHour, SecondPrecision},
+			interval: Interval{0, 1, 3 * SecsInHour, SecondPrecision},
+		},
+
+		// 1
+		{
+			i:        Interval{0, 35, 0, SecondPrecision},
+			hours:    Interval{0, 35, 0, SecondPrecision},
+			days:     Interval{1, 5, 0, SecondPrecision},
+			interval: Interval{1, 5, 0, SecondPrecision},
+		},
+
+		// 2
+		{
+			i:        Interval{0, -35, 0, SecondPrecision},
+			hours:    Interval{0, -35, 0, SecondPrecision},
+			days:     Interval{-1, -5, 0, SecondPrecision},
+			interval: Interval{-1, -5, 0, SecondPrecision},
+		},
+
+		// 3
+		{
+			i:        Interval{1, -1, 0, SecondPrecision},
+			hours:    Interval{1, -1, 0, SecondPrecision},
+			days:     Interval{0, 29, 0, SecondPrecision},
+			interval: Interval{0, 29, 0, SecondPrecision},
+		},
+
+		// 4
+		{
+			i:        Interval{1, 0, -SecsInHour * MicrosecsInSec, MicrosecondPrecision},
+			hours:    Interval{1, 0, -SecsInHour * MicrosecsInSec, MicrosecondPrecision},
+			days:     Interval{1, 0, -SecsInHour * MicrosecsInSec, MicrosecondPrecision},
+			interval: Interval{0, 29, 23 * SecsInHour * MicrosecsInSec, MicrosecondPrecision},
+		},
+
+		// 5
+		{
+			i:        Interval{0, 1, -SecsInHour, SecondPrecision},
+			hours:    Interval{0, 0, 23 * SecsInHour, SecondPrecision},
+			days:     Interval{0, 1, -SecsInHour, SecondPrecision},
+			interval: Interval{0, 0, 23 * SecsInHour, SecondPrecision},
+		},
+
+		// 6
+		{
+			i:        Interval{-1, 30, 48 * SecsInHour, SecondPrecision},
+			hours:    Interval{-1, 32, 0, SecondPrecision},
+			days:     Interval{0, 0, 48 * SecsInHour, SecondPrecision},
+			interval: Interval{0, 2, 0, SecondPrecision},
+		},
+
+		// 7
+		{
+			i:        Interval{0, -1, 25 * SecsInHour, SecondPrecision},
+			hours:    Interval{0, 0, SecsInHour, SecondPrecision},
+			days:     Interval{0, -1, 25 * SecsInHour, SecondPrecision},
+			interval: Interval{0, 0, SecsInHour, SecondPrecision},
+		},
+
+		// 8
+		{
+			i:         Interval{math.MaxInt32, math.MaxInt32, math.MaxInt64, SecondPrecision},
+			hoursErr:  true,
+			daysErr:   true,
+			intervErr: true,
+		},
+
+		// 9
+		{
+			i:        Interval{0, math.MaxInt32, math.MaxInt64, NanosecondPrecision},
+			days:     Interval{71582788, 7, math.MaxInt64, NanosecondPrecision},
+			interval: Interval{71586346, 18, 85636854775807, NanosecondPrecision},
+			hoursErr: true,
+		},
+	}
+
+	for j, v := range test {
+		if i, err := v.i.JustifyHours(); (err != nil) != v.hoursErr {
+			t.Errorf("Test-%v. JustifyHours unexpected error: %v", j, err)
+		} else if !v.hoursErr && i != v.hours {
+			t.Errorf("Test-%v. JustifyHours expected: %v, got: %v", j, v.hours, i)
+		}
+		if i, err := v.i.JustifyDays(); (err != nil) != v.daysErr {
+			t.Errorf("Test-%v. JustifyDays unexpected error: %v", j, err)
+		} else if !v.daysErr && i != v.days {
+			t.Errorf("Test-%v. JustifyDays expected: %v, got: %v", j, v.days, i)
+		}
+		if i, err := v.i.JustifyInterval(); (err != nil) != v.intervErr {
+			t.Errorf("Test-%v. JustifyInterval unexpected error: %v", j, err)
+		} else if !v.intervErr && i != v.interval {
+			t.Errorf("Test-%v. JustifyInterval expected: %v, got: %v", j, v.interval, i)
+		}
+	}
+}
@@ -0,0 +1,215 @@
+package timehelper
+
+import (
+	"errors"
+	"github.com/apaxa-io/strconvhelper"
+	"strings"
+	"sync"
+)
+
+// LocaleUnits are names of units for Locale.
+// Each unit has list of plural forms, index of form for number is returned by Locale.Plural.
+// Each form is a pattern, "%d" in it is replaced with number: "%d years", "%d年".
+type LocaleUnits struct {
+	Years   []string
+	Months  []string
+	Days    []string
+	Hours   []string
+	Minutes []string
+	Seconds []string
+}
+
+// forms returns plural forms for unit u (humanYear, humanMonth and so on).
+func (lu *LocaleUnits) forms(u int) []string {
+	switch u {
+	case humanYear:
+		return lu.Years
+	case humanMonth:
+		return lu.Months
+	case humanDay:
+		return lu.Days
+	case humanHour:
+		return lu.Hours
+	case humanMinute:
+		return lu.Minutes
+	default:
+		return lu.Seconds
+	}
+}
+
+// Locale is a table used by FormatLocale to render interval in some language.
+// Patterns below contain "%s" which is replaced with formatted interval.
+type Locale struct {
+	// Units are names of units.
+	Units LocaleUnits
+	// RelativeUnits are names of units used in relative form ("3 hours ago", "in 2 days") if they differ from Units
+	// (for example, because of grammatical case). If nil, Units are used.
+	RelativeUnits *LocaleUnits
+	// Plural returns index of plural form for non-negative number n.
+	Plural func(n int64) int
+	// ListSeparator separates units: ", ".
+	ListSeparator string
+	// ListLastSeparator separates the last unit from other: " and ".
+	ListLastSeparator string
+	// Approximate is a pattern for approximate value: "about %s".
+	Approximate string
+	// LessThanSecond is used for interval with only fraction of second: "less than a second".
+	LessThanSecond string
+	// Past is a pattern for negative relative interval: "%s ago".
+	Past string
+	// Future is a pattern for positive relative interval: "in %s".
+	Future string
+	// Now is used for zero relative interval: "now".
+	Now string
+	// Negative is a pattern for negative interval in non-relative form: "minus %s". If empty, "-%s" is used.
+	Negative string
+}
+
+// Plural rules for bundled locales.
+
+// PluralOneOther is plural rule with 2 forms: for 1 and for all other numbers (English, German and so on).
+func PluralOneOther(n int64) int {
+	if n == 1 {
+		return 0
+	}
+	return 1
+}
+
+// PluralZeroOneOther is plural rule with 2 forms: for 0 and 1 and for all other numbers (French).
+func PluralZeroOneOther(n int64) int {
+	if n <= 1 {
+		return 0
+	}
+	return 1
+}
+
+// PluralSlavic is plural rule with 3 forms: one (1, 21, 31...), few (2-4, 22-24...) and many (all other numbers) (Russian, Ukrainian).
+func PluralSlavic(n int64) int {
+	switch {
+	case n%10 == 1 && n%100 != 11:
+		return 0
+	case n%10 >= 2 && n%10 <= 4 && (n%100 < 12 || n%100 > 14):
+		return 1
+	default:
+		return 2
+	}
+}
+
+// PluralNone is plural rule with single form for all numbers (Japanese, Chinese and so on).
+func PluralNone(n int64) int {
+	return 0
+}
+
+var (
+	localesMutex sync.RWMutex
+	locales      = map[string]*Locale{}
+)
+
+// RegisterLocale registers locale table l with given name (for example "en" or "pt-BR") for FormatLocale.
+// Name is case insensitive. Registered locale with the same name is replaced.
+// It is safe to call RegisterLocale concurrently with FormatLocale.
+func RegisterLocale(name string, l *Locale) {
+	localesMutex.Lock()
+	defer localesMutex.Unlock()
+	locales[normalizeLocaleName(name)] = l
+}
+
+// LookupLocale returns registered locale table by name.
+// If there is no locale with such name, base language is used: "de-AT" and "de_AT" fall back to "de".
+func LookupLocale(name string) (l *Locale, ok bool) {
+	name = normalizeLocaleName(name)
+
+	localesMutex.RLock()
+	defer localesMutex.RUnlock()
+	if l, ok = locales[name]; !ok {
+		if j := strings.IndexByte(name, '-'); j >= 0 {
+			l, ok = locales[name[:j]]
+		}
+	}
+	return
+}
+
+// normalizeLocaleName returns lower case locale name with "-" as separator.
+func normalizeLocaleName(name string) string {
+	return strings.Replace(strings.ToLower(name), "_", "-", -1)
+}
+
+// FormatLocale returns interval in natural language of given locale (see Humanize for English and RegisterLocale for locales registration).
+// Bundled locales are "en", "de", "fr", "ru" and "ja".
+// Error returned if locale is not registered.
+func FormatLocale(i Interval, locale string, opts HumanizeOptions) (string, error) {
+	l, ok := LookupLocale(locale)
+	if !ok {
+		return "", errors.New("Unknown interval locale " + locale)
+	}
+	return l.format(i, opts), nil
+}
+
+// format returns interval formatted with locale table.
+func (l *Locale) format(i Interval, opts HumanizeOptions) string {
+	v, negative, approximate, lessThanSecond := i.humanize(opts)
+
+	units := &l.Units
+	if opts.Relative && l.RelativeUnits != nil {
+		units = l.RelativeUnits
+	}
+
+	var parts []string
+	for u := range v {
+		if v[u] != 0 {
+			parts = append(parts, l.formatUnit(units, u, v[u]))
+		}
+	}
+
+	var str string
+	switch {
+	case lessThanSecond:
+		str = l.LessThanSecond
+		if negative && !opts.Relative {
+			str = l.negative(str)
+		}
+	case len(parts) == 0:
+		if opts.Relative {
+			return l.Now
+		}
+		return l.formatUnit(units, humanSecond, 0)
+	default:
+		str = parts[len(parts)-1]
+		if len(parts) > 1 {
+			str = strings.Join(parts[:len(parts)-1], l.ListSeparator) + l.ListLastSeparator + str
+		}
+		if negative && !opts.Relative {
+			str = l.negative(str)
+		}
+		if approximate {
+			str = strings.Replace(l.Approximate, "%s", str, 1)
+		}
+	}
+
+	switch {
+	case !opts.Relative:
+		return str
+	case negative:
+		return strings.Replace(l.Past, "%s", str, 1)
+	default:
+		return strings.Replace(l.Future, "%s", str, 1)
+	}
+}
+
+// negative returns str formatted with Negative pattern.
+func (l *Locale) negative(str string) string {
+	if l.Negative == "" {
+		return "-" + str
+	}
+	return strings.Replace(l.Negative, "%s", str, 1)
+}
+
+// formatUnit returns number n of unit u in right plural form.
+func (l *Locale) formatUnit(units *LocaleUnits, u int, n int64) string {
+	forms := units.forms(u)
+	form := l.Plural(n)
+	if form >= len(forms) {
+		form = len(forms) - 1
+	}
+	return strings.Replace(forms[form], "%d", strconvhelper.FormatInt64(n), 1)
+}
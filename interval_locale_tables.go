@@ -0,0 +1,133 @@
+package timehelper
+
+// Bundled locale tables. They are registered by init under "en", "de", "fr", "ru" and "ja".
+var (
+	// LocaleEnglish is English locale table, used by Humanize.
+	LocaleEnglish = &Locale{
+		Units: LocaleUnits{
+			Years:   []string{"%d year", "%d years"},
+			Months:  []string{"%d month", "%d months"},
+			Days:    []string{"%d day", "%d days"},
+			Hours:   []string{"%d hour", "%d hours"},
+			Minutes: []string{"%d minute", "%d minutes"},
+			Seconds: []string{"%d second", "%d seconds"},
+		},
+		Plural:            PluralOneOther,
+		ListSeparator:     ", ",
+		ListLastSeparator: " and ",
+		Approximate:       "about %s",
+		LessThanSecond:    "less than a second",
+		Past:              "%s ago",
+		Future:            "in %s",
+		Now:               "now",
+		Negative:          "minus %s",
+	}
+
+	// LocaleGerman is German locale table.
+	LocaleGerman = &Locale{
+		Units: LocaleUnits{
+			Years:   []string{"%d Jahr", "%d Jahre"},
+			Months:  []string{"%d Monat", "%d Monate"},
+			Days:    []string{"%d Tag", "%d Tage"},
+			Hours:   []string{"%d Stunde", "%d Stunden"},
+			Minutes: []string{"%d Minute", "%d Minuten"},
+			Seconds: []string{"%d Sekunde", "%d Sekunden"},
+		},
+		// Dative: "vor 2 Jahren", "in 3 Tagen".
+		RelativeUnits: &LocaleUnits{
+			Years:   []string{"%d Jahr", "%d Jahren"},
+			Months:  []string{"%d Monat", "%d Monaten"},
+			Days:    []string{"%d Tag", "%d Tagen"},
+			Hours:   []string{"%d Stunde", "%d Stunden"},
+			Minutes: []string{"%d Minute", "%d Minuten"},
+			Seconds: []string{"%d Sekunde", "%d Sekunden"},
+		},
+		Plural:            PluralOneOther,
+		ListSeparator:     ", ",
+		ListLastSeparator: " und ",
+		Approximate:       "etwa %s",
+		LessThanSecond:    "weniger als eine Sekunde",
+		Past:              "vor %s",
+		Future:            "in %s",
+		Now:               "jetzt",
+		Negative:          "minus %s",
+	}
+
+	// LocaleFrench is French locale table.
+	LocaleFrench = &Locale{
+		Units: LocaleUnits{
+			Years:   []string{"%d an", "%d ans"},
+			Months:  []string{"%d mois", "%d mois"},
+			Days:    []string{"%d jour", "%d jours"},
+			Hours:   []string{"%d heure", "%d heures"},
+			Minutes: []string{"%d minute", "%d minutes"},
+			Seconds: []string{"%d seconde", "%d secondes"},
+		},
+		Plural:            PluralZeroOneOther,
+		ListSeparator:     ", ",
+		ListLastSeparator: " et ",
+		Approximate:       "environ %s",
+		LessThanSecond:    "moins d'une seconde",
+		Past:              "il y a %s",
+		Future:            "dans %s",
+		Now:               "maintenant",
+		Negative:          "moins %s",
+	}
+
+	// LocaleRussian is Russian locale table.
+	LocaleRussian = &Locale{
+		Units: LocaleUnits{
+			Years:   []string{"%d год", "%d года", "%d лет"},
+			Months:  []string{"%d месяц", "%d месяца", "%d месяцев"},
+			Days:    []string{"%d день", "%d дня", "%d дней"},
+			Hours:   []string{"%d час", "%d часа", "%d часов"},
+			Minutes: []string{"%d минута", "%d минуты", "%d минут"},
+			Seconds: []string{"%d секунда", "%d секунды", "%d секунд"},
+		},
+		// Accusative: "1 минуту назад", "через 1 секунду".
+		RelativeUnits: &LocaleUnits{
+			Years:   []string{"%d год", "%d года", "%d лет"},
+			Months:  []string{"%d месяц", "%d месяца", "%d месяцев"},
+			Days:    []string{"%d день", "%d дня", "%d дней"},
+			Hours:   []string{"%d час", "%d часа", "%d часов"},
+			Minutes: []string{"%d минуту", "%d минуты", "%d минут"},
+			Seconds: []string{"%d секунду", "%d секунды", "%d секунд"},
+		},
+		Plural:            PluralSlavic,
+		ListSeparator:     ", ",
+		ListLastSeparator: " и ",
+		Approximate:       "примерно %s",
+		LessThanSecond:    "меньше секунды",
+		Past:              "%s назад",
+		Future:            "через %s",
+		Now:               "сейчас",
+		Negative:          "минус %s",
+	}
+
+	// LocaleJapanese is Japanese locale table.
+	LocaleJapanese = &Locale{
+		Units: LocaleUnits{
+			Years:   []string{"%d年"},
+			Months:  []string{"%dか月"},
+			Days:    []string{"%d日"},
+			Hours:   []string{"%d時間"},
+			Minutes: []string{"%d分"},
+			Seconds: []string{"%d秒"},
+		},
+		Plural:         PluralNone,
+		Approximate:    "約%s",
+		LessThanSecond: "1秒未満",
+		Past:           "%s前",
+		Future:         "%s後",
+		Now:            "今",
+		Negative:       "マイナス%s",
+	}
+)
+
+func init() {
+	RegisterLocale("en", LocaleEnglish)
+	RegisterLocale("de", LocaleGerman)
+	RegisterLocale("fr", LocaleFrench)
+	RegisterLocale("ru", LocaleRussian)
+	RegisterLocale("ja", LocaleJapanese)
+}
@@ -0,0 +1,182 @@
+package timehelper
+
+import "testing"
+
+func TestFormatLocale(t *testing.T) {
+	type testElement struct {
+		i      Interval
+		locale string
+		opts   HumanizeOptions
+		s      string
+	}
+
+	test := []testElement{
+		// 0
+		{
+			i:      Interval{27, 4, 0, SecondPrecision},
+			locale: "en",
+			s:      "2 years, 3 months and 4 days",
+		},
+
+		// 1
+		{
+			i:      Interval{27, 1, 0, SecondPrecision},
+			locale: "de",
+			s:      "2 Jahre, 3 Monate und 1 Tag",
+		},
+
+		// 2
+		{
+			i:      Interval{24, 3, 0, SecondPrecision},
+			locale: "de",
+			opts:   HumanizeOptions{Relative: true},
+			s:      "in 2 Jahren und 3 Tagen",
+		},
+
+		// 3
+		{
+			i:      Interval{0, 0, -5 * SecsInMin, SecondPrecision},
+			locale: "de-AT",
+			opts:   HumanizeOptions{Relative: true},
+			s:      "vor 5 Minuten",
+		},
+
+		// 4
+		{
+			i:      Interval{0, 1, 0, SecondPrecision},
+			locale: "fr",
+			s:      "1 jour",
+		},
+
+		// 5
+		{
+			i:      Interval{2, 0, -3 * SecsInHour, SecondPrecision},
+			locale: "FR_ca",
+			opts:   HumanizeOptions{Units: 1, Relative: true},
+			s:      "dans environ 1 mois",
+		},
+
+		// 6
+		{
+			i:      Interval{0, 0, 0, SecondPrecision},
+			locale: "fr",
+			s:      "0 seconde",
+		},
+
+		// 7
+		{
+			i:      Interval{12, 2, 5*SecsInHour + 11*SecsInMin, SecondPrecision},
+			locale: "ru",
+			s:      "1 год, 2 дня, 5 часов и 11 минут",
+		},
+
+		// 8
+		{
+			i:      Interval{0, 0, -(21*SecsInMin + 1), SecondPrecision},
+			locale: "ru",
+			opts:   HumanizeOptions{Relative: true},
+			s:      "21 минуту и 1 секунду назад",
+		},
+
+		// 9
+		{
+			i:      Interval{0, 0, 22*SecsInMin + 14, SecondPrecision},
+			locale: "ru",
+			s:      "22 минуты и 14 секунд",
+		},
+
+		// 10
+		{
+			i:      Interval{0, 0, -300, MillisecondPrecision},
+			locale: "ru",
+			opts:   HumanizeOptions{Relative: true},
+			s:      "меньше секунды назад",
+		},
+
+		// 11
+		{
+			i:      Interval{14, 3, 0, SecondPrecision},
+			locale: "ja",
+			s:      "1年2か月3日",
+		},
+
+		// 12
+		{
+			i:      Interval{0, 0, 2*SecsInHour + 40*SecsInMin, SecondPrecision},
+			locale: "ja",
+			opts:   HumanizeOptions{Units: 1, Round: true, Relative: true},
+			s:      "約3時間後",
+		},
+
+		// 13
+		{
+			i:      Interval{0, 0, 0, SecondPrecision},
+			locale: "ja",
+			opts:   HumanizeOptions{Relative: true},
+			s:      "今",
+		},
+
+		// 14
+		{
+			i:      Interval{0, 0, -(22*SecsInMin + 14), SecondPrecision},
+			locale: "ru",
+			s:      "минус 22 минуты и 14 секунд",
+		},
+	}
+
+	for j, v := range test {
+		if s, err := FormatLocale(v.i, v.locale, v.opts); err != nil || s != v.s {
+			t.Errorf("Test-%v. Expected: %v, got: %v (error: %v)", j, v.s, s, err)
+		}
+	}
+
+	if _, err := FormatLocale(Interval{}, "xx", HumanizeOptions{}); err == nil {
+		t.Error("Expected error for unknown locale")
+	}
+}
+
+func TestPluralSlavic(t *testing.T) {
+	test := map[int64]int{0: 2, 1: 0, 2: 1, 4: 1, 5: 2, 11: 2, 12: 2, 14: 2, 21: 0, 22: 1, 101: 0, 111: 2, 112: 2, 122: 1}
+
+	for n, v := range test {
+		if r := PluralSlavic(n); r != v {
+			t.Errorf("Test-%v. Expected: %v, got: %v", n, v, r)
+		}
+	}
+}
+
+func TestRegisterLocale(t *testing.T) {
+	l := &Locale{
+		Units: LocaleUnits{
+			Years:   []string{"%d år"},
+			Months:  []string{"%d måned", "%d måneder"},
+			Days:    []string{"%d dag", "%d dager"},
+			Hours:   []string{"%d time", "%d timer"},
+			Minutes: []string{"%d minutt", "%d minutter"},
+			Seconds: []string{"%d sekund", "%d sekunder"},
+		},
+		Plural:            PluralOneOther,
+		ListSeparator:     ", ",
+		ListLastSeparator: " og ",
+		Approximate:       "omtrent %s",
+		LessThanSecond:    "mindre enn et sekund",
+		Past:              "for %s siden",
+		Future:            "om %s",
+		Now:               "nå",
+	}
+	RegisterLocale("nb-NO", l)
+
+	if r, ok := LookupLocale("nb_no"); !ok || r != l {
+		t.Error("Registered locale not found")
+	}
+	if _, ok := LookupLocale("nb"); ok {
+		t.Error("Base language should not be registered")
+	}
+	if s, err := FormatLocale(Interval{24, 2, 0, SecondPrecision}, "nb-NO", HumanizeOptions{Relative: true}); err != nil || s != "om 2 år og 2 dager" {
+		t.Errorf("Expected: %v, got: %v (error: %v)", "om 2 år og 2 dager", s, err)
+	}
+	// Locale without Negative pattern.
+	if s, err := FormatLocale(Interval{0, -2, 0, SecondPrecision}, "nb-NO", HumanizeOptions{}); err != nil || s != "-2 dager" {
+		t.Errorf("Expected: %v, got: %v (error: %v)", "-2 dager", s, err)
+	}
+}
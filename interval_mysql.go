@@ -0,0 +1,122 @@
+package timehelper
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"github.com/apaxa-io/mathhelper"
+	"math/big"
+	"regexp"
+	"strconv"
+)
+
+// ErrNotRepresentable is returned by conversions to other databases types if interval has part which can not be stored in target type
+// (for example, months in MySQL TIME).
+var ErrNotRepresentable = errors.New("Interval is not representable in target type")
+
+// mysqlTimeMax is maximum absolute value of MySQL TIME in seconds (838:59:59).
+const mysqlTimeMax = 838*SecsInHour + 59*SecsInMin + 59
+
+// RE for parse MySQL TIME.
+var reMySQLTime = regexp.MustCompile(`^([+-])?(?:([0-9]+) )?([0-9]+):([0-9]{2}):([0-9]{2})(?:\.([0-9]+))?$`)
+
+// MySQLTime returns interval as MySQL TIME value: "[-]HHH:MM:SS[.ffffff]".
+// Days are converted to 24 hours (as MySQL does for "D HH:MM:SS" input), fraction is rounded to microseconds (maximum MySQL TIME precision).
+// Error (matching ErrNotRepresentable) returned if interval has months,
+// error (matching ErrRange) returned if it is out of MySQL TIME range -838:59:59 .. 838:59:59.
+func (i Interval) MySQLTime() (string, error) {
+	if i.Months != 0 {
+		return "", fmt.Errorf("Interval with months can not be converted to MySQL TIME: %w", ErrNotRepresentable)
+	}
+	t := i.approxSomeSeconds(MicrosecondPrecision)
+	if t.CmpAbs(big.NewInt(mysqlTimeMax*MicrosecsInSec)) > 0 {
+		return "", fmt.Errorf("Interval %v is out of MySQL TIME range: %w", i, ErrRange)
+	}
+	return Interval{SomeSeconds: t.Int64(), precision: MicrosecondPrecision}.Format("%H:%M:%S%F"), nil
+}
+
+// ParseMySQLTime parses MySQL TIME value ("[-]HHH:MM:SS[.ffffff]" or "[-]D HH:MM:SS[.ffffff]") and returns interval with requested precision p.
+// Result has only seconds part (days are converted to 24 hours).
+// Error (matching ErrRange) returned if value is out of MySQL TIME range or if minutes or seconds are greater than 59.
+func ParseMySQLTime(s string, p uint8) (i Interval, err error) {
+	if p > maxPrecision {
+		p = maxPrecision
+	}
+	i.precision = p
+
+	parts := reMySQLTime.FindStringSubmatch(s)
+	if parts == nil {
+		err = &ParseError{Input: s, Err: ErrSyntax}
+		return
+	}
+
+	var d, h int64
+	if parts[2] != "" {
+		if d, err = strconv.ParseInt(parts[2], 10, 64); err != nil || d > mysqlTimeMax/SecsInDay {
+			err = fmt.Errorf("MySQL TIME %v days are out of range: %w", s, ErrRange)
+			return
+		}
+	}
+	if h, err = strconv.ParseInt(parts[3], 10, 64); err != nil || h > mysqlTimeMax/SecsInHour {
+		err = fmt.Errorf("MySQL TIME %v hours are out of range: %w", s, ErrRange)
+		return
+	}
+	m, _ := strconv.ParseInt(parts[4], 10, 64)
+	sec, _ := strconv.ParseInt(parts[5], 10, 64)
+	if m >= MinsInHour || sec >= SecsInMin {
+		err = fmt.Errorf("MySQL TIME %v minutes or seconds are out of range: %w", s, ErrRange)
+		return
+	}
+	var f int64
+	if f, err = parseFraction(parts[6], p); err != nil {
+		return
+	}
+
+	sec += ((d*HoursInDay+h)*MinsInHour + m) * SecsInMin
+	if sec > mysqlTimeMax || (sec == mysqlTimeMax && f > 0) {
+		err = fmt.Errorf("MySQL TIME %v is out of range: %w", s, ErrRange)
+		return
+	}
+	i.SomeSeconds = sec*mathhelper.PowInt64(10, int64(p)) + f
+	if parts[1] == "-" {
+		i.SomeSeconds = -i.SomeSeconds
+	}
+	return
+}
+
+// MySQLTimeValue is an Interval which implements driver.Valuer and sql.Scanner interfaces for MySQL TIME columns.
+type MySQLTimeValue Interval
+
+// Value implements the driver.Valuer interface. Interval is passed to driver as string returned by MySQLTime.
+func (v MySQLTimeValue) Value() (driver.Value, error) {
+	return Interval(v).MySQLTime()
+}
+
+// Scan implements the sql.Scanner interface.
+// MySQLTimeValue can be scanned from string or []byte (see ParseMySQLTime), result has microsecond precision.
+func (v *MySQLTimeValue) Scan(src interface{}) error {
+	s, err := scanString("MySQLTimeValue", src)
+	if err != nil {
+		return err
+	}
+	i, err := ParseMySQLTime(s, MicrosecondPrecision)
+	if err != nil {
+		return err
+	}
+	*v = MySQLTimeValue(i)
+	return nil
+}
+
+// scanString returns src as string if it is string or []byte. Name of scanner used in error messages.
+func scanString(name string, src interface{}) (string, error) {
+	switch v := src.(type) {
+	case string:
+		return v, nil
+	case []byte:
+		return string(v), nil
+	case nil:
+		return "", errors.New(name + ".Scan cannot scan NULL value")
+	default:
+		return "", fmt.Errorf("%v.Scan cannot scan %T", name, src)
+	}
+}
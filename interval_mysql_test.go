@@ -0,0 +1,171 @@
+package timehelper
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestInterval_MySQLTime(t *testing.T) {
+	type testElement struct {
+		i   Interval
+		s   string
+		err error
+	}
+
+	test := []testElement{
+		// 0
+		{
+			i: Interval{0, 0, 14706789, MillisecondPrecision},
+			s: "04:05:06.789",
+		},
+
+		// 1
+		{
+			i: Interval{0, 1, -1, SecondPrecision},
+			s: "23:59:59",
+		},
+
+		// 2
+		{
+			i: Interval{0, -2, -3*SecsInHour*NanosecsInSec - 1500, NanosecondPrecision},
+			s: "-51:00:00.000002",
+		},
+
+		// 3
+		{
+			i: Interval{0, 0, -mysqlTimeMax, SecondPrecision},
+			s: "-838:59:59",
+		},
+
+		// 4
+		{
+			i:   Interval{0, 0, mysqlTimeMax*MicrosecsInSec + 1, MicrosecondPrecision},
+			err: ErrRange,
+		},
+
+		// 5
+		{
+			i:   Interval{0, 35, 0, MicrosecondPrecision},
+			err: ErrRange,
+		},
+
+		// 6
+		{
+			i:   Interval{1, 0, 0, MicrosecondPrecision},
+			err: ErrNotRepresentable,
+		},
+	}
+
+	for j, v := range test {
+		s, err := v.i.MySQLTime()
+		if v.err != nil {
+			if !errors.Is(err, v.err) {
+				t.Errorf("Test-%v. Expected error %v, got: %v", j, v.err, err)
+			}
+			continue
+		}
+		if err != nil || s != v.s {
+			t.Errorf("Test-%v. Expected: %v, got: %v (error: %v)", j, v.s, s, err)
+		}
+	}
+}
+
+func TestParseMySQLTime(t *testing.T) {
+	type testElement struct {
+		s   string
+		p   uint8
+		i   Interval
+		err bool
+	}
+
+	test := []testElement{
+		// 0
+		{
+			s: "04:05:06.789",
+			p: MicrosecondPrecision,
+			i: Interval{0, 0, 14706789000, MicrosecondPrecision},
+		},
+
+		// 1
+		{
+			s: "-838:59:59.000000",
+			p: SecondPrecision,
+			i: Interval{0, 0, -mysqlTimeMax, SecondPrecision},
+		},
+
+		// 2
+		{
+			s: "2 03:00:00.5",
+			p: SecondPrecision,
+			i: Interval{0, 0, 51*SecsInHour + 1, SecondPrecision},
+		},
+
+		// 3
+		{
+			s:   "838:59:59.1",
+			p:   MicrosecondPrecision,
+			err: true,
+		},
+
+		// 4
+		{
+			s:   "839:00:00",
+			p:   MicrosecondPrecision,
+			err: true,
+		},
+
+		// 5
+		{
+			s:   "10:60:00",
+			p:   MicrosecondPrecision,
+			err: true,
+		},
+
+		// 6
+		{
+			s:   "1 year",
+			p:   MicrosecondPrecision,
+			err: true,
+		},
+
+		// 7
+		{
+			s:   "99999999999999999999:00:00",
+			p:   MicrosecondPrecision,
+			err: true,
+		},
+	}
+
+	for j, v := range test {
+		i, err := ParseMySQLTime(v.s, v.p)
+		if (err != nil) != v.err {
+			t.Errorf("Test-%v. Unexpected error: %v", j, err)
+		} else if !v.err && i != v.i {
+			t.Errorf("Test-%v. Expected: %v, got: %v", j, v.i, i)
+		}
+	}
+}
+
+func TestMySQLTimeValue(t *testing.T) {
+	v := MySQLTimeValue(Interval{0, 0, -90, SecondPrecision})
+	if s, err := v.Value(); err != nil || s != "-00:01:30" {
+		t.Errorf("Unexpected value: %v (error: %v)", s, err)
+	}
+	if _, err := MySQLTimeValue(Interval{1, 0, 0, SecondPrecision}).Value(); err == nil {
+		t.Error("Expected error for interval with months")
+	}
+
+	for j, src := range []interface{}{"-00:01:30", []byte("-00:01:30")} {
+		var r MySQLTimeValue
+		if err := r.Scan(src); err != nil || r != MySQLTimeValue(Interval{0, 0, -90 * MicrosecsInSec, MicrosecondPrecision}) {
+			t.Errorf("Test-%v. Unexpected scan result: %v (error: %v)", j, Interval(r), err)
+		}
+	}
+	var r MySQLTimeValue
+	if err := r.Scan(nil); err == nil {
+		t.Error("Expected error for NULL")
+	}
+	if err := r.Scan(int64(1)); err == nil {
+		t.Error("Expected error for int64")
+	}
+}
@@ -0,0 +1,166 @@
+package timehelper
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"github.com/apaxa-io/mathhelper"
+	"github.com/apaxa-io/strconvhelper"
+	"github.com/apaxa-io/stringshelper"
+	"math"
+	"math/big"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// oracleDaysMax is maximum absolute value of days in Oracle INTERVAL DAY(9) TO SECOND.
+const oracleDaysMax = 999999999
+
+// REs for parse Oracle intervals.
+var (
+	reOracleYearToMonth = regexp.MustCompile(`^([+-])?([0-9]+)-([0-9]+)$`)
+	reOracleDayToSecond = regexp.MustCompile(`^([+-])?([0-9]+) ([0-9]+):([0-9]+):([0-9]+)(?:\.([0-9]+))?$`)
+)
+
+// OracleYearToMonth returns interval as Oracle INTERVAL YEAR TO MONTH literal: "[-]Y-MM" (can be used with TO_YMINTERVAL).
+// Error (matching ErrNotRepresentable) returned if interval has days or seconds.
+func (i Interval) OracleYearToMonth() (string, error) {
+	if i.Days != 0 || i.SomeSeconds != 0 {
+		return "", fmt.Errorf("Interval with days or seconds can not be converted to Oracle INTERVAL YEAR TO MONTH: %w", ErrNotRepresentable)
+	}
+	m := int64(i.Months)
+	sign := ""
+	if m < 0 {
+		sign, m = "-", -m
+	}
+	return sign + strconvhelper.FormatInt64(m/MonthsInYear) + "-" + stringshelper.PadLeftWithByte(strconvhelper.FormatInt64(m%MonthsInYear), '0', 2), nil
+}
+
+// ParseOracleYearToMonth parses Oracle INTERVAL YEAR TO MONTH value ("[+-]Y-M", for example "+01-02") and returns interval with requested precision p.
+// Error (matching ErrRange) returned if months are greater than 11 or if value does not fit in Interval.
+func ParseOracleYearToMonth(s string, p uint8) (i Interval, err error) {
+	i = NewInterval(p)
+
+	parts := reOracleYearToMonth.FindStringSubmatch(s)
+	if parts == nil {
+		err = &ParseError{Input: s, Err: ErrSyntax}
+		return
+	}
+	y, err1 := strconv.ParseInt(parts[2], 10, 32)
+	m, err2 := strconv.ParseInt(parts[3], 10, 32)
+	months := y*MonthsInYear + m
+	if parts[1] == "-" {
+		months = -months
+	}
+	if err1 != nil || err2 != nil || m >= MonthsInYear || months > math.MaxInt32 || months < math.MinInt32 {
+		err = fmt.Errorf("Oracle INTERVAL YEAR TO MONTH %v is out of range: %w", s, ErrRange)
+		return
+	}
+	i.Months = int32(months)
+	return
+}
+
+// OracleDayToSecond returns interval as Oracle INTERVAL DAY TO SECOND literal: "[-]D HH:MM:SS[.fffffffff]" (can be used with TO_DSINTERVAL).
+// Oracle interval has single sign and hours less than 24, so days are converted to 24 hours and result is normalized:
+// "1 days -01:00:00" is "0 23:00:00". Fraction is rounded to nanoseconds (maximum Oracle precision).
+// Error (matching ErrNotRepresentable) returned if interval has months,
+// error (matching ErrRange) returned if number of days does not fit in INTERVAL DAY(9) TO SECOND.
+func (i Interval) OracleDayToSecond() (string, error) {
+	if i.Months != 0 {
+		return "", fmt.Errorf("Interval with months can not be converted to Oracle INTERVAL DAY TO SECOND: %w", ErrNotRepresentable)
+	}
+	t := i.approxSomeSeconds(NanosecondPrecision)
+	d, s := new(big.Int).QuoRem(t, big.NewInt(SecsInDay*NanosecsInSec), new(big.Int))
+	if d.CmpAbs(big.NewInt(oracleDaysMax)) > 0 {
+		return "", fmt.Errorf("Interval %v is out of Oracle INTERVAL DAY TO SECOND range: %w", i, ErrRange)
+	}
+	r := Interval{Days: int32(d.Int64()), SomeSeconds: s.Int64(), precision: NanosecondPrecision}
+	if t.Sign() < 0 {
+		r = r.Mul(-1)
+		return r.Format("-%D %H:%M:%S%F"), nil
+	}
+	return r.Format("%D %H:%M:%S%F"), nil
+}
+
+// ParseOracleDayToSecond parses Oracle INTERVAL DAY TO SECOND value ("[+-]D HH:MM:SS[.fffffffff]", for example "+000000003 04:05:06.000000")
+// and returns interval with requested precision p. Sign is applied to both days and seconds parts of result.
+// Error (matching ErrRange) returned if hours are greater than 23, minutes or seconds are greater than 59 or days are out of Oracle range.
+func ParseOracleDayToSecond(s string, p uint8) (i Interval, err error) {
+	i = NewInterval(p)
+
+	parts := reOracleDayToSecond.FindStringSubmatch(s)
+	if parts == nil {
+		err = &ParseError{Input: s, Err: ErrSyntax}
+		return
+	}
+	d, err1 := strconv.ParseInt(parts[2], 10, 64)
+	h, err2 := strconv.ParseInt(parts[3], 10, 64)
+	m, err3 := strconv.ParseInt(parts[4], 10, 64)
+	sec, err4 := strconv.ParseInt(parts[5], 10, 64)
+	if err1 != nil || err2 != nil || err3 != nil || err4 != nil || d > oracleDaysMax || h >= HoursInDay || m >= MinsInHour || sec >= SecsInMin {
+		err = fmt.Errorf("Oracle INTERVAL DAY TO SECOND %v is out of range: %w", s, ErrRange)
+		return
+	}
+	var f int64
+	if f, err = parseFraction(parts[6], i.precision); err != nil {
+		return
+	}
+
+	i.Days = int32(d)
+	i.SomeSeconds = ((h*MinsInHour+m)*SecsInMin+sec)*mathhelper.PowInt64(10, int64(i.precision)) + f
+	if parts[1] == "-" {
+		i.Days, i.SomeSeconds = -i.Days, -i.SomeSeconds
+	}
+	return
+}
+
+// OracleYearToMonthValue is an Interval which implements driver.Valuer and sql.Scanner interfaces for Oracle INTERVAL YEAR TO MONTH columns.
+type OracleYearToMonthValue Interval
+
+// Value implements the driver.Valuer interface. Interval is passed to driver as string returned by OracleYearToMonth.
+func (v OracleYearToMonthValue) Value() (driver.Value, error) {
+	return Interval(v).OracleYearToMonth()
+}
+
+// Scan implements the sql.Scanner interface.
+// OracleYearToMonthValue can be scanned from string or []byte (see ParseOracleYearToMonth), result has default (nanosecond) precision.
+func (v *OracleYearToMonthValue) Scan(src interface{}) error {
+	s, err := scanString("OracleYearToMonthValue", src)
+	if err != nil {
+		return err
+	}
+	i, err := ParseOracleYearToMonth(s, defaultPrecision)
+	if err != nil {
+		return err
+	}
+	*v = OracleYearToMonthValue(i)
+	return nil
+}
+
+// OracleDayToSecondValue is an Interval which implements driver.Valuer and sql.Scanner interfaces for Oracle INTERVAL DAY TO SECOND columns.
+type OracleDayToSecondValue Interval
+
+// Value implements the driver.Valuer interface. Interval is passed to driver as string returned by OracleDayToSecond.
+func (v OracleDayToSecondValue) Value() (driver.Value, error) {
+	return Interval(v).OracleDayToSecond()
+}
+
+// Scan implements the sql.Scanner interface.
+// OracleDayToSecondValue can be scanned from string or []byte (see ParseOracleDayToSecond)
+// or from time.Duration (some Oracle drivers return INTERVAL DAY TO SECOND as time.Duration), result has nanosecond precision.
+func (v *OracleDayToSecondValue) Scan(src interface{}) error {
+	if d, ok := src.(time.Duration); ok {
+		*v = OracleDayToSecondValue(FromDuration(d))
+		return nil
+	}
+	s, err := scanString("OracleDayToSecondValue", src)
+	if err != nil {
+		return err
+	}
+	i, err := ParseOracleDayToSecond(s, NanosecondPrecision)
+	if err != nil {
+		return err
+	}
+	*v = OracleDayToSecondValue(i)
+	return nil
+}
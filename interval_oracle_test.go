@@ -0,0 +1,223 @@
+package timehelper
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestInterval_OracleYearToMonth(t *testing.T) {
+	type testElement struct {
+		i   Interval
+		s   string
+		err bool
+	}
+
+	test := []testElement{
+		// 0
+		{
+			i: Interval{14, 0, 0, SecondPrecision},
+			s: "1-02",
+		},
+
+		// 1
+		{
+			i: Interval{-5, 0, 0, SecondPrecision},
+			s: "-0-05",
+		},
+
+		// 2
+		{
+			i: Interval{math.MinInt32, 0, 0, SecondPrecision},
+			s: "-178956970-08",
+		},
+
+		// 3
+		{
+			i:   Interval{1, 1, 0, SecondPrecision},
+			err: true,
+		},
+
+		// 4
+		{
+			i:   Interval{1, 0, 1, SecondPrecision},
+			err: true,
+		},
+	}
+
+	for j, v := range test {
+		s, err := v.i.OracleYearToMonth()
+		if v.err {
+			if !errors.Is(err, ErrNotRepresentable) {
+				t.Errorf("Test-%v. Expected error, got: %v", j, err)
+			}
+			continue
+		}
+		if err != nil || s != v.s {
+			t.Errorf("Test-%v. Expected: %v, got: %v (error: %v)", j, v.s, s, err)
+			continue
+		}
+		if i, err := ParseOracleYearToMonth(s, v.i.Precision()); err != nil || i != v.i {
+			t.Errorf("Test-%v. Parse expected: %v, got: %v (error: %v)", j, v.i, i, err)
+		}
+	}
+
+	for j, s := range []string{"+01-12", "1 year", "99999999999-00", "178956970-08"} {
+		if _, err := ParseOracleYearToMonth(s, SecondPrecision); err == nil {
+			t.Errorf("Test-%v. Expected error for %v", j, s)
+		}
+	}
+	if i, err := ParseOracleYearToMonth("+01-02", SecondPrecision); err != nil || i != (Interval{14, 0, 0, SecondPrecision}) {
+		t.Errorf("Unexpected result: %v (error: %v)", i, err)
+	}
+}
+
+func TestInterval_OracleDayToSecond(t *testing.T) {
+	type testElement struct {
+		i   Interval
+		s   string
+		err error
+	}
+
+	test := []testElement{
+		// 0
+		{
+			i: Interval{0, 3, 14706789, MillisecondPrecision},
+			s: "3 04:05:06.789",
+		},
+
+		// 1
+		{
+			i: Interval{0, 1, -SecsInHour, SecondPrecision},
+			s: "0 23:00:00",
+		},
+
+		// 2
+		{
+			i: Interval{0, 0, -(27*SecsInHour*PicosecsInSec + 1500), PicosecondPrecision},
+			s: "-1 03:00:00.000000002",
+		},
+
+		// 3
+		{
+			i: Interval{0, 0, 0, SecondPrecision},
+			s: "0 00:00:00",
+		},
+
+		// 4
+		{
+			i:   Interval{1, 0, 0, SecondPrecision},
+			err: ErrNotRepresentable,
+		},
+
+		// 5
+		{
+			i:   Interval{0, math.MaxInt32, 0, SecondPrecision},
+			err: ErrRange,
+		},
+	}
+
+	for j, v := range test {
+		s, err := v.i.OracleDayToSecond()
+		if v.err != nil {
+			if !errors.Is(err, v.err) {
+				t.Errorf("Test-%v. Expected error %v, got: %v", j, v.err, err)
+			}
+			continue
+		}
+		if err != nil || s != v.s {
+			t.Errorf("Test-%v. Expected: %v, got: %v (error: %v)", j, v.s, s, err)
+		}
+	}
+}
+
+func TestParseOracleDayToSecond(t *testing.T) {
+	type testElement struct {
+		s   string
+		p   uint8
+		i   Interval
+		err bool
+	}
+
+	test := []testElement{
+		// 0
+		{
+			s: "+000000003 04:05:06.000000",
+			p: MicrosecondPrecision,
+			i: Interval{0, 3, 14706 * MicrosecsInSec, MicrosecondPrecision},
+		},
+
+		// 1
+		{
+			s: "-1 03:00:00.000000002",
+			p: NanosecondPrecision,
+			i: Interval{0, -1, -(3*SecsInHour*NanosecsInSec + 2), NanosecondPrecision},
+		},
+
+		// 2
+		{
+			s: "0 00:00:00.5",
+			p: SecondPrecision,
+			i: Interval{0, 0, 1, SecondPrecision},
+		},
+
+		// 3
+		{
+			s:   "1 24:00:00",
+			err: true,
+		},
+
+		// 4
+		{
+			s:   "1 00:60:00",
+			err: true,
+		},
+
+		// 5
+		{
+			s:   "1000000000 00:00:00",
+			err: true,
+		},
+
+		// 6
+		{
+			s:   "04:05:06",
+			err: true,
+		},
+	}
+
+	for j, v := range test {
+		i, err := ParseOracleDayToSecond(v.s, v.p)
+		if (err != nil) != v.err {
+			t.Errorf("Test-%v. Unexpected error: %v", j, err)
+		} else if !v.err && i != v.i {
+			t.Errorf("Test-%v. Expected: %v, got: %v", j, v.i, i)
+		}
+	}
+}
+
+func TestOracleValues(t *testing.T) {
+	if s, err := OracleYearToMonthValue(Interval{14, 0, 0, SecondPrecision}).Value(); err != nil || s != "1-02" {
+		t.Errorf("Unexpected value: %v (error: %v)", s, err)
+	}
+	if s, err := OracleDayToSecondValue(Interval{0, 1, 1, SecondPrecision}).Value(); err != nil || s != "1 00:00:01" {
+		t.Errorf("Unexpected value: %v (error: %v)", s, err)
+	}
+
+	var ym OracleYearToMonthValue
+	if err := ym.Scan([]byte("-01-02")); err != nil || ym != OracleYearToMonthValue(Interval{-14, 0, 0, defaultPrecision}) {
+		t.Errorf("Unexpected scan result: %v (error: %v)", Interval(ym), err)
+	}
+
+	var ds OracleDayToSecondValue
+	if err := ds.Scan("+02 01:00:00.5"); err != nil || ds != OracleDayToSecondValue(Interval{0, 2, SecsInHour*NanosecsInSec + 5e8, NanosecondPrecision}) {
+		t.Errorf("Unexpected scan result: %v (error: %v)", Interval(ds), err)
+	}
+	if err := ds.Scan(90 * time.Minute); err != nil || ds != OracleDayToSecondValue(FromDuration(90*time.Minute)) {
+		t.Errorf("Unexpected scan result: %v (error: %v)", Interval(ds), err)
+	}
+	if err := ds.Scan(nil); err == nil {
+		t.Error("Expected error for NULL")
+	}
+}
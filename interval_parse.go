@@ -0,0 +1,327 @@
+package timehelper
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"strconv"
+	"unicode/utf8"
+)
+
+// Sentinel errors which may be checked with errors.Is.
+var (
+	// ErrSyntax means that string does not match expected format.
+	ErrSyntax = errors.New("Invalid interval syntax")
+	// ErrOverflow means that value does not fit in Interval. OverflowError matches it.
+	ErrOverflow = errors.New("Interval overflow")
+	// ErrPrecision means that requested precision is out of range [0; 12].
+	ErrPrecision = errors.New("Interval precision out of range")
+	// ErrRange means that field value is out of range (for example, minutes greater than 59 in strict mode).
+	ErrRange = errors.New("Interval field out of range")
+)
+
+// ParseOptions controls ParseWithOptions.
+type ParseOptions struct {
+	// Precision is precision of result.
+	Precision uint8
+	// Strict rejects input accepted by Parse for compatibility only:
+	// empty string, leading, trailing or repeated spaces, components without space between them ("1 year2 mons"),
+	// minutes and seconds greater than 59, decimal separator other than '.' (including trailing ',')
+	// and Precision greater than 12 (ErrPrecision, in lenient mode it is replaced with 12 as by other functions).
+	Strict bool
+}
+
+// Components of interval string reported by ParseError.
+const (
+	ComponentYears    = "years"
+	ComponentMonths   = "months"
+	ComponentDays     = "days"
+	ComponentTime     = "time"
+	ComponentFraction = "fraction"
+)
+
+// ParseError describes a problem parsing interval string by Parse and ParseWithOptions.
+// Other parsers (ParseStyle, ParseISO8601, ParseInput, ParseGoDuration, ParseMySQLTime and Oracle ones) return it with ErrSyntax cause
+// if string does not match format, but they do not report component and report offset only if it is known (zero otherwise).
+// Cause may be checked with errors.Is (ErrSyntax, ErrOverflow, ErrPrecision, ErrRange) or errors.As (*OverflowError).
+type ParseError struct {
+	Input     string // String being parsed.
+	Component string // Failed component (ComponentYears, ComponentMonths and so on) or empty string if error is not related to particular component.
+	Offset    int    // Byte offset in Input where problem is found.
+	Err       error  // Cause.
+}
+
+// Error returns the string representation of a ParseError.
+func (e *ParseError) Error() string {
+	str := "Unable to parse interval from string " + strconv.Quote(e.Input) + ": "
+	if e.Component != "" {
+		str += e.Component + " "
+	}
+	return str + "at offset " + strconv.Itoa(e.Offset) + ": " + e.Err.Error()
+}
+
+// Unwrap returns the cause of ParseError.
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// Is reports if target is ErrOverflow, so OverflowError may be checked with errors.Is.
+func (e *OverflowError) Is(target error) bool {
+	return target == ErrOverflow
+}
+
+// postgresParser parses interval in postgres style specification.
+// http://www.postgresql.org/docs/9.4/interactive/datatype-datetime.html#DATATYPE-INTERVAL-OUTPUT
+// Format is "[Y year[s]][ ][M mon[s]][ ][D day[s]][ ][[+-]H:M:S[(,|.)F]]" where "." is any character.
+type postgresParser struct {
+	s      string
+	pos    int
+	strict bool
+	err    *ParseError // Error which is not a syntax error (found even if string matches syntax).
+
+	// The farthest position where parsing of some component failed and this component (empty if it is ambiguous).
+	errPos       int
+	errComponent string
+}
+
+// fail remembers that parsing of component c started at ps.pos failed at position pos.
+// Failure is ignored if nothing has been consumed, so only partially matched components are reported.
+func (ps *postgresParser) fail(pos int, c string) {
+	switch {
+	case pos == ps.pos:
+	case pos > ps.errPos:
+		ps.errPos, ps.errComponent = pos, c
+	case pos == ps.errPos && c != ps.errComponent:
+		ps.errComponent = ""
+	}
+}
+
+// skipSpace skips single optional space.
+func (ps *postgresParser) skipSpace() {
+	if ps.pos < len(ps.s) && ps.s[ps.pos] == ' ' {
+		ps.pos++
+	}
+}
+
+// digits returns digits starting from position pos.
+func (ps *postgresParser) digits(pos int) string {
+	j := pos
+	for j < len(ps.s) && ps.s[j] >= '0' && ps.s[j] <= '9' {
+		j++
+	}
+	return ps.s[pos:j]
+}
+
+// unit parses signed number followed by space and unit name with optional trailing 's' ("1 year", "-2 mons").
+// Unit name should not be followed by other letters ("2 monz" is reported as failed component c).
+// If it does not match when ok is false and position is not changed.
+func (ps *postgresParser) unit(unit, c string) (v *big.Int, start int, ok bool) {
+	start = ps.pos
+	j := start
+	if j < len(ps.s) && (ps.s[j] == '+' || ps.s[j] == '-') {
+		j++
+	}
+	d := ps.digits(j)
+	if d == "" {
+		ps.fail(j, c)
+		return
+	}
+	j += len(d)
+	if len(ps.s)-j < len(unit)+1 || ps.s[j] != ' ' || ps.s[j+1:j+1+len(unit)] != unit {
+		ps.fail(j, c)
+		return
+	}
+	v, _ = new(big.Int).SetString(ps.s[start:j], 10)
+	j += len(unit) + 1
+	if j < len(ps.s) && ps.s[j] == 's' {
+		j++
+	}
+	if j < len(ps.s) && (ps.s[j] >= 'a' && ps.s[j] <= 'z' || ps.s[j] >= 'A' && ps.s[j] <= 'Z') {
+		ps.fail(j, c)
+		return nil, start, false
+	}
+	ps.pos = j
+	return v, start, true
+}
+
+// time parses "[+-]H:M:S[(,|.)F]" and returns its parts, sign and fraction digits.
+// If it does not match when ok is false and position is not changed.
+func (ps *postgresParser) time() (negative bool, h, m, s *big.Int, f string, ok bool) {
+	j := ps.pos
+	if j < len(ps.s) && (ps.s[j] == '+' || ps.s[j] == '-') {
+		negative = ps.s[j] == '-'
+		j++
+	}
+	var parts [3]*big.Int
+	for k := range parts {
+		if k > 0 {
+			if j >= len(ps.s) || ps.s[j] != ':' {
+				ps.fail(j, ComponentTime)
+				return
+			}
+			j++
+		}
+		d := ps.digits(j)
+		if d == "" {
+			ps.fail(j, ComponentTime)
+			return
+		}
+		parts[k], _ = new(big.Int).SetString(d, 10)
+		if ps.strict && k > 0 && parts[k].Cmp(big.NewInt(SecsInMin)) >= 0 && ps.err == nil {
+			ps.err = &ParseError{Input: ps.s, Component: ComponentTime, Offset: j, Err: ErrRange}
+		}
+		j += len(d)
+	}
+
+	// Fraction: "," alone or any character followed by digits (only "." in strict mode).
+	if j < len(ps.s) {
+		if ps.s[j] == ',' && j+1 == len(ps.s) && !ps.strict {
+			j++
+		} else {
+			r, size := utf8.DecodeRuneInString(ps.s[j:])
+			f = ps.digits(j + size)
+			if r == '\n' || f == "" || (ps.strict && r != '.') {
+				ps.fail(j+size, ComponentFraction)
+				return
+			}
+			j += size + len(f)
+		}
+	}
+
+	ps.pos = j
+	return negative, parts[0], parts[1], parts[2], f, true
+}
+
+// ParseWithOptions parses incoming string in postgres style (see Parse) according to opts.
+// In lenient mode (default) it is the same as Parse, strict mode is useful for validation of user input (see ParseOptions.Strict).
+// Examples of strings rejected in strict mode:
+// 	"" and "   "
+// 	"1 year  2 days", "1 year2 mons" and "1 year "
+// 	"2 year -34:56:78" (out of range minutes and seconds is ErrRange)
+// 	"04:05:06,789" and "04:05:06x789"
+func ParseWithOptions(s string, opts ParseOptions) (i Interval, err error) {
+	p := opts.Precision
+	if p > maxPrecision {
+		if opts.Strict {
+			return NewInterval(maxPrecision), &ParseError{Input: s, Err: ErrPrecision}
+		}
+		p = maxPrecision
+	}
+	i.precision = p
+
+	ps := postgresParser{s: s, strict: opts.Strict}
+	var b bigInterval
+	yearsPos, monthsPos, daysPos, timePos := -1, -1, -1, -1
+	var negative bool
+	var h, m, sec *big.Int
+	var f string
+
+	slots := [...]func() bool{
+		func() bool {
+			v, start, ok := ps.unit("year", ComponentYears)
+			if ok {
+				b.months.Mul(v, big.NewInt(MonthsInYear))
+				yearsPos = start
+			}
+			return ok
+		},
+		func() bool {
+			v, start, ok := ps.unit("mon", ComponentMonths)
+			if ok {
+				b.months.Add(&b.months, v)
+				monthsPos = start
+			}
+			return ok
+		},
+		func() bool {
+			v, start, ok := ps.unit("day", ComponentDays)
+			if ok {
+				b.days.Set(v)
+				daysPos = start
+			}
+			return ok
+		},
+		func() bool {
+			start := ps.pos
+			var ok bool
+			if negative, h, m, sec, f, ok = ps.time(); ok {
+				timePos = start
+			}
+			return ok
+		},
+	}
+
+	parsed := false
+	for k, slot := range slots {
+		if !ps.strict {
+			// Single optional space is allowed after each (even missed) component, so string of 1-3 spaces is valid.
+			if k > 0 {
+				ps.skipSpace()
+			}
+			slot()
+			continue
+		}
+
+		// Exactly one space is required between components.
+		start := ps.pos
+		if parsed {
+			if ps.pos >= len(s) || s[ps.pos] != ' ' {
+				break
+			}
+			ps.pos++
+		}
+		if slot() {
+			parsed = true
+		} else {
+			ps.pos = start
+		}
+	}
+
+	if ps.err != nil {
+		return i, ps.err
+	}
+	if ps.pos != len(s) {
+		if ps.pos >= ps.errPos {
+			return i, &ParseError{Input: s, Offset: ps.pos, Err: ErrSyntax}
+		}
+		return i, &ParseError{Input: s, Component: ps.errComponent, Offset: ps.errPos, Err: ErrSyntax}
+	}
+	if ps.strict && !parsed {
+		return i, &ParseError{Input: s, Err: ErrSyntax}
+	}
+
+	if h != nil {
+		b.someSeconds.Mul(h, big.NewInt(MinsInHour))
+		b.someSeconds.Add(&b.someSeconds, m)
+		b.someSeconds.Mul(&b.someSeconds, big.NewInt(SecsInMin))
+		b.someSeconds.Add(&b.someSeconds, sec)
+		b.someSeconds.Mul(&b.someSeconds, pow10Big(p))
+		if f != "" {
+			var ti int64
+			if ti, err = parseFraction(f, p); err != nil {
+				return i, &ParseError{Input: s, Component: ComponentFraction, Offset: len(s) - len(f), Err: err}
+			}
+			b.someSeconds.Add(&b.someSeconds, big.NewInt(ti))
+		}
+		if negative {
+			b.someSeconds.Neg(&b.someSeconds)
+		}
+	}
+
+	if _, ok := clampBig(&b.months, math.MinInt32, math.MaxInt32); !ok {
+		c, pos := ComponentMonths, monthsPos
+		if pos < 0 {
+			c, pos = ComponentYears, yearsPos
+		}
+		return i, &ParseError{Input: s, Component: c, Offset: pos, Err: &OverflowError{Op: "Parse"}}
+	}
+	if _, ok := clampBig(&b.days, math.MinInt32, math.MaxInt32); !ok {
+		return i, &ParseError{Input: s, Component: ComponentDays, Offset: daysPos, Err: &OverflowError{Op: "Parse"}}
+	}
+	if _, ok := clampBig(&b.someSeconds, math.MinInt64, math.MaxInt64); !ok {
+		return i, &ParseError{Input: s, Component: ComponentTime, Offset: timePos, Err: &OverflowError{Op: "Parse"}}
+	}
+
+	i, _ = b.interval(p)
+	return
+}
@@ -0,0 +1,360 @@
+package timehelper
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseError(t *testing.T) {
+	type testElement struct {
+		s         string
+		p         uint8
+		component string
+		offset    int
+		err       error
+	}
+
+	test := []testElement{
+		// 0
+		{
+			s:         "1 year 2 mons 3 dayz",
+			p:         MicrosecondPrecision,
+			component: ComponentDays,
+			offset:    19,
+			err:       ErrSyntax,
+		},
+
+		// 1
+		{
+			s:         "1 year 12:3x:00",
+			p:         MicrosecondPrecision,
+			component: ComponentTime,
+			offset:    11,
+			err:       ErrSyntax,
+		},
+
+		// 2
+		{
+			s:         "12:00:00.x",
+			p:         MicrosecondPrecision,
+			component: ComponentFraction,
+			offset:    9,
+			err:       ErrSyntax,
+		},
+
+		// 3
+		{
+			s:         "-",
+			p:         MicrosecondPrecision,
+			component: "",
+			offset:    1,
+			err:       ErrSyntax,
+		},
+
+		// 4
+		{
+			s:         "1 mons 200000000 days",
+			p:         MicrosecondPrecision,
+			component: "",
+			offset:    0,
+			err:       nil,
+		},
+
+		// 5
+		{
+			s:         "1 mons 3000000000 days",
+			p:         MicrosecondPrecision,
+			component: ComponentDays,
+			offset:    7,
+			err:       ErrOverflow,
+		},
+
+		// 6
+		{
+			s:         "200000000 years 1 mons",
+			p:         MicrosecondPrecision,
+			component: ComponentMonths,
+			offset:    16,
+			err:       ErrOverflow,
+		},
+
+		// 7
+		{
+			s:         "1 year 9999999999:00:00",
+			p:         MicrosecondPrecision,
+			component: ComponentTime,
+			offset:    7,
+			err:       ErrOverflow,
+		},
+
+		// 8
+		{
+			s:         "00:00:00",
+			p:         13,
+			component: "",
+			offset:    0,
+			err:       nil,
+		},
+
+		// 9
+		{
+			s:         "1 year 2 monz",
+			p:         MicrosecondPrecision,
+			component: ComponentMonths,
+			offset:    12,
+			err:       ErrSyntax,
+		},
+
+		// 10
+		{
+			s:         "1 yearss",
+			p:         MicrosecondPrecision,
+			component: ComponentYears,
+			offset:    7,
+			err:       ErrSyntax,
+		},
+
+		// 11
+		{
+			s:         "1 year2 mons",
+			p:         MicrosecondPrecision,
+			component: "",
+			offset:    0,
+			err:       nil,
+		},
+	}
+
+	for j, v := range test {
+		_, err := Parse(v.s, v.p)
+		if v.err == nil {
+			if err != nil {
+				t.Errorf("Test-%v. Unexpected error: %v", j, err)
+			}
+			continue
+		}
+		var e *ParseError
+		if !errors.As(err, &e) {
+			t.Errorf("Test-%v. Expected ParseError, got: %v", j, err)
+			continue
+		}
+		if e.Input != v.s || e.Component != v.component || e.Offset != v.offset || !errors.Is(err, v.err) {
+			t.Errorf("Test-%v. Expected: %v %v %v, got: %v %v %v", j, v.component, v.offset, v.err, e.Component, e.Offset, e.Err)
+		}
+	}
+}
+
+func TestParseWithOptions(t *testing.T) {
+	type testElement struct {
+		s      string
+		i      Interval
+		err    error // expected error in strict mode, nil if valid
+		offset int
+	}
+
+	test := []testElement{
+		// 0
+		{
+			s: "-1 year 2 mons -3 days 04:05:06.789",
+			i: Interval{-10, -3, 14706789, MillisecondPrecision},
+		},
+
+		// 1
+		{
+			s: "1 mons",
+			i: Interval{1, 0, 0, MillisecondPrecision},
+		},
+
+		// 2
+		{
+			s:      "2 year -34:56:78",
+			i:      Interval{24, 0, -(34*SecsInHour + 56*SecsInMin + 78) * 1000, MillisecondPrecision},
+			err:    ErrRange,
+			offset: 14,
+		},
+
+		// 3
+		{
+			s:      "",
+			i:      Interval{0, 0, 0, MillisecondPrecision},
+			err:    ErrSyntax,
+			offset: 0,
+		},
+
+		// 4
+		{
+			s:      "   ",
+			i:      Interval{0, 0, 0, MillisecondPrecision},
+			err:    ErrSyntax,
+			offset: 0,
+		},
+
+		// 5
+		{
+			s:      "1 year  2 days",
+			i:      Interval{12, 2, 0, MillisecondPrecision},
+			err:    ErrSyntax,
+			offset: 6,
+		},
+
+		// 6
+		{
+			s:      "1 year2 mons",
+			i:      Interval{14, 0, 0, MillisecondPrecision},
+			err:    ErrSyntax,
+			offset: 6,
+		},
+
+		// 7
+		{
+			s:      "1 day ",
+			i:      Interval{0, 1, 0, MillisecondPrecision},
+			err:    ErrSyntax,
+			offset: 5,
+		},
+
+		// 8
+		{
+			s:      "04:05:06,789",
+			i:      Interval{0, 0, 14706789, MillisecondPrecision},
+			err:    ErrSyntax,
+			offset: 9,
+		},
+
+		// 9
+		{
+			s:      "04:05:06x789",
+			i:      Interval{0, 0, 14706789, MillisecondPrecision},
+			err:    ErrSyntax,
+			offset: 9,
+		},
+
+		// 10
+		{
+			s:      "04:05:06,",
+			i:      Interval{0, 0, 14706000, MillisecondPrecision},
+			err:    ErrSyntax,
+			offset: 9,
+		},
+
+		// 11
+		{
+			s:      "04:60:00",
+			i:      Interval{0, 0, 5 * SecsInHour * 1000, MillisecondPrecision},
+			err:    ErrRange,
+			offset: 3,
+		},
+	}
+
+	for j, v := range test {
+		if i, err := ParseWithOptions(v.s, ParseOptions{Precision: MillisecondPrecision}); err != nil || i != v.i {
+			t.Errorf("Test-%v. Lenient expected: %v, got: %v (error: %v)", j, v.i, i, err)
+		}
+
+		i, err := ParseWithOptions(v.s, ParseOptions{Precision: MillisecondPrecision, Strict: true})
+		if v.err == nil {
+			if err != nil || i != v.i {
+				t.Errorf("Test-%v. Strict expected: %v, got: %v (error: %v)", j, v.i, i, err)
+			}
+			continue
+		}
+		var e *ParseError
+		if !errors.As(err, &e) || !errors.Is(err, v.err) || e.Offset != v.offset {
+			t.Errorf("Test-%v. Strict expected error %v at offset %v, got: %v", j, v.err, v.offset, err)
+		}
+	}
+	// Precision greater than 12 is replaced in lenient mode and rejected in strict mode.
+	if i, err := ParseWithOptions("00:00:01", ParseOptions{Precision: 13}); err != nil || i != (Interval{0, 0, PicosecsInSec, PicosecondPrecision}) {
+		t.Errorf("Lenient expected: %v, got: %v (error: %v)", Interval{0, 0, PicosecsInSec, PicosecondPrecision}, i, err)
+	}
+	if _, err := ParseWithOptions("00:00:01", ParseOptions{Precision: 13, Strict: true}); !errors.Is(err, ErrPrecision) {
+		t.Errorf("Strict expected ErrPrecision, got: %v", err)
+	}
+}
+
+func TestParseErrorSyntax(t *testing.T) {
+	type testElement struct {
+		parse  func(s string, p uint8) (Interval, error)
+		s      string
+		offset int
+	}
+
+	parseVerbose := func(s string, p uint8) (Interval, error) { return ParseStyle(s, StylePostgresVerbose, p) }
+	parseSQLStandard := func(s string, p uint8) (Interval, error) { return ParseStyle(s, StyleSQLStandard, p) }
+
+	test := []testElement{
+		// 0
+		{
+			parse: parseVerbose,
+			s:     "@ 1 fortnight",
+		},
+
+		// 1
+		{
+			parse: parseSQLStandard,
+			s:     "1-2 3 4:05:06 7",
+		},
+
+		// 2
+		{
+			parse: ParseISO8601,
+			s:     "P1DT",
+		},
+
+		// 3
+		{
+			parse: ParseInput,
+			s:     "1 fortnight",
+		},
+
+		// 4
+		{
+			parse: ParseInput,
+			s:     "",
+		},
+
+		// 5
+		{
+			parse: ParseGoDuration,
+			s:     "",
+		},
+
+		// 6
+		{
+			parse:  ParseGoDuration,
+			s:      "1h30x",
+			offset: 2,
+		},
+
+		// 7
+		{
+			parse: ParseMySQLTime,
+			s:     "1 year",
+		},
+
+		// 8
+		{
+			parse: ParseOracleYearToMonth,
+			s:     "1 year",
+		},
+
+		// 9
+		{
+			parse: ParseOracleDayToSecond,
+			s:     "1 year",
+		},
+
+		// 10
+		{
+			parse: Parse,
+			s:     "fortnight",
+		},
+	}
+
+	for j, v := range test {
+		_, err := v.parse(v.s, MicrosecondPrecision)
+		var e *ParseError
+		if !errors.As(err, &e) || !errors.Is(err, ErrSyntax) || e.Input != v.s || e.Offset != v.offset {
+			t.Errorf("Test-%v. Expected ParseError with ErrSyntax at offset %v, got: %v", j, v.offset, err)
+		}
+	}
+}
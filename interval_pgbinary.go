@@ -0,0 +1,38 @@
+package timehelper
+
+import (
+	"encoding/binary"
+	"errors"
+	"strconv"
+)
+
+// PostgreSQLBinaryLen is length of interval in PostgreSQL binary wire format.
+const PostgreSQLBinaryLen = 8 + 4 + 4
+
+// AppendPostgreSQLBinary appends interval in PostgreSQL binary wire format to buf and returns the extended buffer.
+// Format is microseconds (int64), days (int32) and months (int32) in network byte order.
+// Seconds part is converted to PostgreSQL precision, OverflowError returned if it does not fit.
+// It can be used to implement interval support in PostgreSQL drivers.
+func (i Interval) AppendPostgreSQLBinary(buf []byte) ([]byte, error) {
+	r, err := i.SetPrecisionChecked(PostgreSQLPrecision)
+	if err != nil {
+		return buf, err
+	}
+	buf = binary.BigEndian.AppendUint64(buf, uint64(r.SomeSeconds))
+	buf = binary.BigEndian.AppendUint32(buf, uint32(r.Days))
+	return binary.BigEndian.AppendUint32(buf, uint32(r.Months)), nil
+}
+
+// ParsePostgreSQLBinary parses interval in PostgreSQL binary wire format (see AppendPostgreSQLBinary).
+// Result has PostgreSQL precision.
+func ParsePostgreSQLBinary(b []byte) (i Interval, err error) {
+	if len(b) != PostgreSQLBinaryLen {
+		err = errors.New("Invalid length of binary interval: " + strconv.Itoa(len(b)))
+		return
+	}
+	i.SomeSeconds = int64(binary.BigEndian.Uint64(b))
+	i.Days = int32(binary.BigEndian.Uint32(b[8:]))
+	i.Months = int32(binary.BigEndian.Uint32(b[12:]))
+	i.precision = PostgreSQLPrecision
+	return
+}
@@ -0,0 +1,62 @@
+package timehelper
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestPostgreSQLBinary(t *testing.T) {
+	type testElement struct {
+		i   Interval
+		b   []byte
+		res Interval
+		err bool
+	}
+
+	test := []testElement{
+		// 0
+		{
+			i:   Interval{14, 3, 14706789 * 1e3, PostgreSQLPrecision},
+			b:   []byte{0, 0, 0, 3, 108, 151, 202, 136, 0, 0, 0, 3, 0, 0, 0, 14},
+			res: Interval{14, 3, 14706789 * 1e3, PostgreSQLPrecision},
+		},
+
+		// 1
+		{
+			i:   Interval{-1, -2, -1500, NanosecondPrecision},
+			b:   []byte{255, 255, 255, 255, 255, 255, 255, 254, 255, 255, 255, 254, 255, 255, 255, 255},
+			res: Interval{-1, -2, -2, PostgreSQLPrecision},
+		},
+
+		// 2
+		{
+			i:   Interval{0, 0, 1 << 62, SecondPrecision},
+			err: true,
+		},
+	}
+
+	for j, v := range test {
+		b, err := v.i.AppendPostgreSQLBinary([]byte{})
+		if (err != nil) != v.err {
+			t.Errorf("Test-%v. Unexpected error: %v", j, err)
+			continue
+		}
+		if v.err {
+			continue
+		}
+		if !bytes.Equal(b, v.b) {
+			t.Errorf("Test-%v. Expected: %v, got: %v", j, v.b, b)
+		}
+
+		i, err := ParsePostgreSQLBinary(b)
+		if err != nil {
+			t.Errorf("Test-%v. Unexpected error: %v", j, err)
+		} else if i != v.res {
+			t.Errorf("Test-%v. Expected: %v, got: %v", j, v.res, i)
+		}
+	}
+
+	if _, err := ParsePostgreSQLBinary(make([]byte, PostgreSQLBinaryLen-1)); err == nil {
+		t.Errorf("Expected error for short binary interval")
+	}
+}
@@ -0,0 +1,64 @@
+package timehelper
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+)
+
+// Scan implements the sql.Scanner interface.
+// Interval can be scanned from string or []byte in any PostgreSQL IntervalStyle (see Style), result has PostgreSQL precision.
+// NULL can not be scanned to Interval, use NullInterval for nullable columns.
+func (i *Interval) Scan(src interface{}) (err error) {
+	var s string
+	switch v := src.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	case nil:
+		return errors.New("Interval.Scan cannot scan NULL value, use NullInterval instead")
+	default:
+		return fmt.Errorf("Interval.Scan cannot scan %T", src)
+	}
+
+	r, err := ParseStyle(s, DetectStyle(s), PostgreSQLPrecision)
+	if err != nil {
+		return
+	}
+	*i = r
+	return
+}
+
+// Value implements the driver.Valuer interface.
+// Interval is passed to driver as string in ISO 8601 format (as ISO8601 returns).
+// Each field of it is signed independently, so PostgreSQL reads it in the same way with any IntervalStyle
+// (in postgres style "-3 days 00:00:05" is read as "-3 days -00:00:05" with sql_standard IntervalStyle).
+func (i Interval) Value() (driver.Value, error) {
+	return i.ISO8601(), nil
+}
+
+// NullInterval represents an Interval that may be NULL.
+// NullInterval implements the sql.Scanner interface so it can be used as a scan destination, similar to sql.NullString.
+type NullInterval struct {
+	Interval Interval
+	Valid    bool // Valid is true if Interval is not NULL
+}
+
+// Scan implements the sql.Scanner interface.
+func (n *NullInterval) Scan(src interface{}) error {
+	if src == nil {
+		n.Interval, n.Valid = Interval{}, false
+		return nil
+	}
+	n.Valid = true
+	return n.Interval.Scan(src)
+}
+
+// Value implements the driver.Valuer interface.
+func (n NullInterval) Value() (driver.Value, error) {
+	if !n.Valid {
+		return nil, nil
+	}
+	return n.Interval.Value()
+}
@@ -0,0 +1,147 @@
+package timehelper
+
+import (
+	"database/sql/driver"
+	"testing"
+)
+
+func TestScan(t *testing.T) {
+	type testElement struct {
+		src interface{}
+		i   Interval
+		err bool
+	}
+
+	test := []testElement{
+		// 0
+		{
+			src: "-1 year 2 mons -3 days 04:05:06.789",
+			i:   Interval{-10, -3, 14706789 * 1e3, PostgreSQLPrecision},
+		},
+
+		// 1
+		{
+			src: []byte("@ 1 year 2 mons -3 days 4 hours 5 mins 6.789 secs ago"),
+			i:   Interval{-14, 3, -14706789 * 1e3, PostgreSQLPrecision},
+		},
+
+		// 2
+		{
+			src: "+1-2 -3 +4:05:06.789",
+			i:   Interval{14, -3, 14706789 * 1e3, PostgreSQLPrecision},
+		},
+
+		// 3
+		{
+			src: "P1Y2M-3DT4H5M6.789S",
+			i:   Interval{14, -3, 14706789 * 1e3, PostgreSQLPrecision},
+		},
+
+		// 4
+		{
+			src: "00:00:00.0000004",
+			i:   Interval{0, 0, 0, PostgreSQLPrecision},
+		},
+
+		// 5
+		{
+			src: nil,
+			err: true,
+		},
+
+		// 6
+		{
+			src: int64(10),
+			err: true,
+		},
+
+		// 7
+		{
+			src: "1 fortnight",
+			err: true,
+		},
+	}
+
+	for j, v := range test {
+		var i Interval
+		err := i.Scan(v.src)
+		if (err != nil) != v.err {
+			t.Errorf("Test-%v. Unexpected error: %v", j, err)
+		} else if !v.err && (i != v.i) {
+			t.Errorf("Test-%v. Expected: %v, got: %v", j, v.i, i)
+		}
+	}
+}
+
+func TestValue(t *testing.T) {
+	type testElement struct {
+		i Interval
+		s string
+	}
+
+	test := []testElement{
+		// 0
+		{
+			i: Interval{-10, -3, 14706789 * 1e3, PostgreSQLPrecision},
+			s: "P-10M-3DT4H5M6.789S",
+		},
+
+		// 1
+		{
+			i: Interval{0, 0, 1, NanosecondPrecision},
+			s: "PT0.000000001S",
+		},
+
+		// 2
+		{
+			i: NewPgInterval(),
+			s: "PT0S",
+		},
+
+		// 3 Mixed signs: each field should keep its own sign with any IntervalStyle.
+		{
+			i: Interval{0, -3, 5e6, PostgreSQLPrecision},
+			s: "P-3DT5S",
+		},
+
+		// 4
+		{
+			i: Interval{14, 0, -1, PostgreSQLPrecision},
+			s: "P1Y2MT-0.000001S",
+		},
+	}
+
+	for j, v := range test {
+		d, err := v.i.Value()
+		if err != nil {
+			t.Errorf("Test-%v. Unexpected error: %v", j, err)
+			continue
+		}
+		if d != v.s {
+			t.Errorf("Test-%v. Expected value: %v, got: %v", j, v.s, d)
+		}
+		var i Interval
+		if err = i.Scan(d); err != nil {
+			t.Errorf("Test-%v. Unexpected error: %v", j, err)
+		} else if !i.Equal(v.i.SetPrecision(PostgreSQLPrecision)) {
+			t.Errorf("Test-%v. Expected: %v, got: %v", j, v.i, i)
+		}
+	}
+}
+
+func TestNullInterval(t *testing.T) {
+	var n NullInterval
+	if err := n.Scan("1 day"); err != nil || !n.Valid || n.Interval != (Interval{0, 1, 0, PostgreSQLPrecision}) {
+		t.Errorf("Expected valid interval, got: %v (error: %v)", n, err)
+	}
+	if v, err := n.Value(); err != nil || v != "P1D" {
+		t.Errorf("Expected value \"P1D\", got: %v (error: %v)", v, err)
+	}
+
+	if err := n.Scan(nil); err != nil || n.Valid || n.Interval != (Interval{}) {
+		t.Errorf("Expected NULL interval, got: %v (error: %v)", n, err)
+	}
+	if v, err := n.Value(); err != nil || v != driver.Value(nil) {
+		t.Errorf("Expected nil value, got: %v (error: %v)", v, err)
+	}
+}
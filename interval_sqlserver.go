@@ -0,0 +1,55 @@
+package timehelper
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math"
+)
+
+// SQLServerTicksPrecision is precision of SQL Server ticks (100 nanoseconds, as .NET TimeSpan.Ticks).
+const SQLServerTicksPrecision = 7
+
+// SQLServerTicks returns interval as number of 100 nanoseconds ticks (as .NET TimeSpan.Ticks), usually stored in SQL Server bigint column.
+// Days are converted to 24 hours, fraction is rounded to ticks.
+// Error (matching ErrNotRepresentable) returned if interval has months, OverflowError returned if result does not fit in int64.
+func (i Interval) SQLServerTicks() (int64, error) {
+	if i.Months != 0 {
+		return 0, fmt.Errorf("Interval with months can not be converted to SQL Server ticks: %w", ErrNotRepresentable)
+	}
+	t, ok := clampBig(i.approxSomeSeconds(SQLServerTicksPrecision), math.MinInt64, math.MaxInt64)
+	if !ok {
+		return 0, &OverflowError{Op: "SQLServerTicks"}
+	}
+	return t, nil
+}
+
+// FromSQLServerTicks returns interval with requested precision p equal to t ticks of 100 nanoseconds (as .NET TimeSpan.Ticks).
+// Result has only seconds part. If p is less than SQLServerTicksPrecision ticks are rounded.
+// OverflowError returned if result does not fit in Interval with precision p.
+func FromSQLServerTicks(t int64, p uint8) (Interval, error) {
+	i := Interval{SomeSeconds: t, precision: SQLServerTicksPrecision}
+	r, err := i.SetPrecisionChecked(p)
+	if err != nil {
+		return r, &OverflowError{Op: "FromSQLServerTicks"}
+	}
+	return r, nil
+}
+
+// SQLServerTicksValue is an Interval which implements driver.Valuer and sql.Scanner interfaces for SQL Server bigint columns storing ticks.
+type SQLServerTicksValue Interval
+
+// Value implements the driver.Valuer interface. Interval is passed to driver as int64 returned by SQLServerTicks.
+func (v SQLServerTicksValue) Value() (driver.Value, error) {
+	return Interval(v).SQLServerTicks()
+}
+
+// Scan implements the sql.Scanner interface.
+// SQLServerTicksValue can be scanned from int64, result has SQLServerTicksPrecision precision.
+func (v *SQLServerTicksValue) Scan(src interface{}) error {
+	t, ok := src.(int64)
+	if !ok {
+		return fmt.Errorf("SQLServerTicksValue.Scan cannot scan %T", src)
+	}
+	*v = SQLServerTicksValue(Interval{SomeSeconds: t, precision: SQLServerTicksPrecision})
+	return nil
+}
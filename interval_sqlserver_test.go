@@ -0,0 +1,112 @@
+package timehelper
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestInterval_SQLServerTicks(t *testing.T) {
+	type testElement struct {
+		i     Interval
+		ticks int64
+		err   bool
+	}
+
+	test := []testElement{
+		// 0
+		{
+			i:     Interval{0, 1, 1500, MillisecondPrecision},
+			ticks: (SecsInDay+1)*1e7 + 5e6,
+		},
+
+		// 1
+		{
+			i:     Interval{0, 0, -150, NanosecondPrecision},
+			ticks: -2,
+		},
+
+		// 2
+		{
+			i:   Interval{0, 0, math.MaxInt64, SecondPrecision},
+			err: true,
+		},
+
+		// 3
+		{
+			i:   Interval{1, 0, 0, SecondPrecision},
+			err: true,
+		},
+	}
+
+	for j, v := range test {
+		ticks, err := v.i.SQLServerTicks()
+		if (err != nil) != v.err {
+			t.Errorf("Test-%v. Unexpected error: %v", j, err)
+		} else if !v.err && ticks != v.ticks {
+			t.Errorf("Test-%v. Expected: %v, got: %v", j, v.ticks, ticks)
+		}
+	}
+
+	if _, err := (Interval{1, 0, 0, SecondPrecision}).SQLServerTicks(); !errors.Is(err, ErrNotRepresentable) {
+		t.Errorf("Expected ErrNotRepresentable, got: %v", err)
+	}
+	if _, err := (Interval{0, 0, math.MaxInt64, SecondPrecision}).SQLServerTicks(); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected ErrOverflow, got: %v", err)
+	}
+}
+
+func TestFromSQLServerTicks(t *testing.T) {
+	type testElement struct {
+		ticks int64
+		p     uint8
+		i     Interval
+		err   bool
+	}
+
+	test := []testElement{
+		// 0
+		{
+			ticks: 15e6,
+			p:     MillisecondPrecision,
+			i:     Interval{0, 0, 1500, MillisecondPrecision},
+		},
+
+		// 1
+		{
+			ticks: -15,
+			p:     MicrosecondPrecision,
+			i:     Interval{0, 0, -2, MicrosecondPrecision},
+		},
+
+		// 2
+		{
+			ticks: math.MaxInt64,
+			p:     NanosecondPrecision,
+			err:   true,
+		},
+	}
+
+	for j, v := range test {
+		i, err := FromSQLServerTicks(v.ticks, v.p)
+		if (err != nil) != v.err {
+			t.Errorf("Test-%v. Unexpected error: %v", j, err)
+		} else if !v.err && i != v.i {
+			t.Errorf("Test-%v. Expected: %v, got: %v", j, v.i, i)
+		}
+	}
+}
+
+func TestSQLServerTicksValue(t *testing.T) {
+	if ticks, err := SQLServerTicksValue(Interval{0, 0, 1, SecondPrecision}).Value(); err != nil || ticks != int64(1e7) {
+		t.Errorf("Unexpected value: %v (error: %v)", ticks, err)
+	}
+
+	var v SQLServerTicksValue
+	if err := v.Scan(int64(1e7)); err != nil || Interval(v) != (Interval{0, 0, 1e7, SQLServerTicksPrecision}) {
+		t.Errorf("Unexpected scan result: %v (error: %v)", Interval(v), err)
+	}
+	if err := v.Scan("1"); err == nil {
+		t.Error("Expected error for string")
+	}
+}
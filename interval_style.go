@@ -0,0 +1,368 @@
+package timehelper
+
+import (
+	"errors"
+	"github.com/apaxa-io/mathhelper"
+	"github.com/apaxa-io/strconvhelper"
+	"github.com/apaxa-io/stringshelper"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Style is a PostgreSQL IntervalStyle - format used for interval input and output.
+// http://www.postgresql.org/docs/9.4/interactive/datatype-datetime.html#DATATYPE-INTERVAL-OUTPUT
+type Style uint8
+
+const (
+	// StylePostgres is default PostgreSQL style: "-1 year -2 mons +3 days -04:05:06".
+	StylePostgres Style = iota
+	// StylePostgresVerbose is postgres_verbose style: "@ 1 year 2 mons -3 days 4 hours 5 mins 6 secs ago".
+	StylePostgresVerbose
+	// StyleSQLStandard is sql_standard style: "-1-2 -3 -4:05:06" or "+1-2 +3 +4:05:06" if interval has mixed signs or both year-month and day-time parts.
+	StyleSQLStandard
+	// StyleISO8601 is iso_8601 style: "P1Y2M3DT4H5M6S".
+	StyleISO8601
+)
+
+// RE for parse interval in postgres_verbose style.
+var reVerbose = regexp.MustCompile(`^@(?: ([+-]?[0-9]+) years?)?(?: ([+-]?[0-9]+) mons?)?(?: ([+-]?[0-9]+) days?)?(?: ([+-]?[0-9]+) hours?)?(?: ([+-]?[0-9]+) mins?)?(?: ([+-])?([0-9]+)(?:\.([0-9]+))? secs?)?( 0)?( ago)?$`)
+
+// REs for parse fields of interval in sql_standard style.
+var (
+	reSQLYearMonth = regexp.MustCompile(`^([+-])?([0-9]+)-([0-9]+)$`)
+	reSQLDay       = regexp.MustCompile(`^([+-])?([0-9]+)$`)
+	reSQLTime      = regexp.MustCompile(`^([+-])?([0-9]+):([0-9]+):([0-9]+)(?:\.([0-9]+))?$`)
+)
+
+// String returns name of style as used in PostgreSQL IntervalStyle setting.
+func (s Style) String() string {
+	switch s {
+	case StylePostgres:
+		return "postgres"
+	case StylePostgresVerbose:
+		return "postgres_verbose"
+	case StyleSQLStandard:
+		return "sql_standard"
+	case StyleISO8601:
+		return "iso_8601"
+	default:
+		return "Style(" + strconv.Itoa(int(s)) + ")"
+	}
+}
+
+// DetectStyle guesses style of string representation of interval.
+// It is used when style of incoming string is unknown (for example when reading interval in text format from PostgreSQL).
+func DetectStyle(s string) Style {
+	switch {
+	case strings.HasPrefix(s, "@"):
+		return StylePostgresVerbose
+	case strings.HasPrefix(s, "P"), strings.HasPrefix(s, "-P"), strings.HasPrefix(s, "+P"):
+		return StyleISO8601
+	case s == "" || strings.ContainsAny(s, "abcdefghijklmnopqrstuvwxyz"):
+		return StylePostgres
+	default:
+		return StyleSQLStandard
+	}
+}
+
+// ParseStyle parses incoming string in given style and extract interval with requested precision p.
+func ParseStyle(s string, style Style, p uint8) (Interval, error) {
+	switch style {
+	case StylePostgres:
+		return Parse(s, p)
+	case StylePostgresVerbose:
+		return parseVerbose(s, p)
+	case StyleSQLStandard:
+		return parseSQLStandard(s, p)
+	case StyleISO8601:
+		return ParseISO8601(s, p)
+	default:
+		return NewInterval(p), errors.New("Unknown interval style " + style.String())
+	}
+}
+
+// FormatStyle returns string representation of interval in given style.
+// Output is the same as PostgreSQL produce with relative IntervalStyle setting (except StylePostgres which is the same as String).
+// Unknown style is formatted as StylePostgres.
+func (i Interval) FormatStyle(style Style) string {
+	switch style {
+	case StylePostgresVerbose:
+		return i.formatVerbose()
+	case StyleSQLStandard:
+		return i.formatSQLStandard()
+	case StyleISO8601:
+		return i.ISO8601()
+	default:
+		return i.String()
+	}
+}
+
+// parseVerbose parses interval in postgres_verbose style.
+// Examples:
+// 	@ 1 year 2 mons -3 days 4 hours 5 mins 6.789 secs ago
+// 	@ 0
+func parseVerbose(s string, p uint8) (i Interval, err error) {
+	i = NewInterval(p)
+
+	parts := reVerbose.FindStringSubmatch(s)
+	if parts == nil || len(parts) != 11 || s == "@" {
+		err = &ParseError{Input: s, Err: ErrSyntax}
+		return
+	}
+
+	var v [6]big.Int // years, months, days, hours, minutes, seconds
+	for j := range v[:5] {
+		if parts[j+1] != "" {
+			v[j].SetString(parts[j+1], 10)
+		}
+	}
+	if parts[7] != "" {
+		v[5].SetString(parts[6]+parts[7], 10)
+	}
+
+	var b bigInterval
+	b.months.Mul(&v[0], big.NewInt(MonthsInYear))
+	b.months.Add(&b.months, &v[1])
+	b.days.Set(&v[2])
+	b.someSeconds.Mul(&v[3], big.NewInt(MinsInHour))
+	b.someSeconds.Add(&b.someSeconds, &v[4])
+	b.someSeconds.Mul(&b.someSeconds, big.NewInt(SecsInMin))
+	b.someSeconds.Add(&b.someSeconds, &v[5])
+	b.someSeconds.Mul(&b.someSeconds, pow10Big(i.precision))
+	if parts[8] != "" {
+		var frac int64
+		if frac, err = parseFraction(parts[8], i.precision); err != nil {
+			return
+		}
+		if parts[6] == "-" {
+			frac = -frac
+		}
+		b.someSeconds.Add(&b.someSeconds, big.NewInt(frac))
+	}
+
+	if parts[10] != "" {
+		b.months.Neg(&b.months)
+		b.days.Neg(&b.days)
+		b.someSeconds.Neg(&b.someSeconds)
+	}
+
+	var ok bool
+	if i, ok = b.interval(i.precision); !ok {
+		err = &OverflowError{Op: "ParseStyle"}
+	}
+	return
+}
+
+// formatVerbose returns string representation of interval in postgres_verbose style.
+// Sign of the first non-zero field is moved to the "ago" suffix, signs of other fields are relative to it.
+func (i Interval) formatVerbose() string {
+	h, m, s, f := i.timeParts()
+
+	str := "@"
+	isZero, isBefore := true, false
+
+	addPart := func(v int64, unit string) {
+		if v == 0 {
+			return
+		}
+		if isZero {
+			isBefore = v < 0
+			v = mathhelper.AbsInt64(v)
+		} else if isBefore {
+			v = -v
+		}
+		str += " " + strconvhelper.FormatInt64(v) + " " + unit
+		if v != 1 {
+			str += "s"
+		}
+		isZero = false
+	}
+
+	addPart(int64(i.NormalYears()), "year")
+	addPart(int64(i.NormalMonths()), "mon")
+	addPart(int64(i.Days), "day")
+	addPart(h, "hour")
+	addPart(m, "min")
+
+	if s != 0 || f != 0 {
+		str += " "
+		if s < 0 || f < 0 {
+			if isZero {
+				isBefore = true
+			} else if !isBefore {
+				str += "-"
+			}
+		} else if isBefore {
+			str += "-"
+		}
+		str += strconvhelper.FormatInt64(mathhelper.AbsInt64(s)) + formatFraction(f, i.precision) + " sec"
+		if mathhelper.AbsInt64(s) != 1 || f != 0 {
+			str += "s"
+		}
+		isZero = false
+	}
+
+	if isZero {
+		str += " 0"
+	}
+	if isBefore {
+		str += " ago"
+	}
+
+	return str
+}
+
+// parseSQLStandard parses interval in sql_standard style.
+// If only the first field is signed when its sign applies to all fields, otherwise each field has its own sign.
+// Examples:
+// 	1-2
+// 	-3 4:05:06.789
+// 	+1-2 -3 +4:05:06
+// 	0
+func parseSQLStandard(s string, p uint8) (i Interval, err error) {
+	i = NewInterval(p)
+
+	fields := strings.Split(s, " ")
+	if len(fields) > 3 {
+		err = &ParseError{Input: s, Err: ErrSyntax}
+		return
+	}
+
+	signs := 0
+	firstNegative := false
+	for j, f := range fields {
+		if f != "" && (f[0] == '-' || f[0] == '+') {
+			signs++
+			if j == 0 {
+				firstNegative = f[0] == '-'
+			}
+		}
+	}
+
+	var b bigInterval
+	j := 0
+
+	// year-month
+	if j < len(fields) {
+		if parts := reSQLYearMonth.FindStringSubmatch(fields[j]); parts != nil {
+			var m big.Int
+			b.months.SetString(parts[2], 10)
+			m.SetString(parts[3], 10)
+			b.months.Mul(&b.months, big.NewInt(MonthsInYear))
+			b.months.Add(&b.months, &m)
+			if parts[1] == "-" {
+				b.months.Neg(&b.months)
+			}
+			j++
+		}
+	}
+
+	// day (only if followed by time) or seconds (if it is the last field)
+	if j < len(fields) {
+		if parts := reSQLDay.FindStringSubmatch(fields[j]); parts != nil {
+			if j == len(fields)-1 {
+				b.someSeconds.SetString(parts[1]+parts[2], 10)
+				b.someSeconds.Mul(&b.someSeconds, pow10Big(i.precision))
+			} else {
+				b.days.SetString(parts[1]+parts[2], 10)
+			}
+			j++
+		}
+	}
+
+	// time
+	if j < len(fields) {
+		if parts := reSQLTime.FindStringSubmatch(fields[j]); parts != nil {
+			var v [3]big.Int // hours, minutes, seconds
+			for k := range v {
+				v[k].SetString(parts[k+2], 10)
+			}
+			b.someSeconds.Mul(&v[0], big.NewInt(MinsInHour))
+			b.someSeconds.Add(&b.someSeconds, &v[1])
+			b.someSeconds.Mul(&b.someSeconds, big.NewInt(SecsInMin))
+			b.someSeconds.Add(&b.someSeconds, &v[2])
+			b.someSeconds.Mul(&b.someSeconds, pow10Big(i.precision))
+			if parts[5] != "" {
+				var frac int64
+				if frac, err = parseFraction(parts[5], i.precision); err != nil {
+					return
+				}
+				b.someSeconds.Add(&b.someSeconds, big.NewInt(frac))
+			}
+			if parts[1] == "-" {
+				b.someSeconds.Neg(&b.someSeconds)
+			}
+			j++
+		}
+	}
+
+	if j != len(fields) || j == 0 {
+		err = &ParseError{Input: s, Err: ErrSyntax}
+		return
+	}
+
+	if signs == 1 && firstNegative {
+		// Sign of the first field applies to all fields.
+		for _, v := range [...]*big.Int{&b.months, &b.days, &b.someSeconds} {
+			if v.Sign() > 0 {
+				v.Neg(v)
+			}
+		}
+	}
+
+	var ok bool
+	if i, ok = b.interval(i.precision); !ok {
+		err = &OverflowError{Op: "ParseStyle"}
+	}
+
+	return
+}
+
+// formatSQLStandard returns string representation of interval in sql_standard style.
+func (i Interval) formatSQLStandard() string {
+	y, mon, d := int64(i.NormalYears()), int64(i.NormalMonths()), int64(i.Days)
+	h, m, s, f := i.timeParts()
+
+	hasNegative := y < 0 || mon < 0 || d < 0 || i.SomeSeconds < 0
+	hasPositive := y > 0 || mon > 0 || d > 0 || i.SomeSeconds > 0
+	hasYearMonth := y != 0 || mon != 0
+	hasDayTime := d != 0 || i.SomeSeconds != 0
+	isStandard := !(hasNegative && hasPositive) && !(hasYearMonth && hasDayTime)
+
+	formatTime := func() string {
+		return strconvhelper.FormatInt64(mathhelper.AbsInt64(h)) + ":" +
+			stringshelper.PadLeftWithByte(strconvhelper.FormatInt64(mathhelper.AbsInt64(m)), '0', 2) + ":" +
+			stringshelper.PadLeftWithByte(strconvhelper.FormatInt64(mathhelper.AbsInt64(s)), '0', 2) +
+			formatFraction(f, i.precision)
+	}
+	sign := func(negative bool) string {
+		if negative {
+			return "-"
+		}
+		return "+"
+	}
+
+	switch {
+	case !hasNegative && !hasPositive:
+		return "0"
+	case !isStandard:
+		return sign(y < 0 || mon < 0) + strconvhelper.FormatInt64(mathhelper.AbsInt64(y)) + "-" + strconvhelper.FormatInt64(mathhelper.AbsInt64(mon)) + " " +
+			sign(d < 0) + strconvhelper.FormatInt64(mathhelper.AbsInt64(d)) + " " +
+			sign(i.SomeSeconds < 0) + formatTime()
+	}
+
+	str := ""
+	if hasNegative {
+		str = "-"
+	}
+	switch {
+	case hasYearMonth:
+		return str + strconvhelper.FormatInt64(mathhelper.AbsInt64(y)) + "-" + strconvhelper.FormatInt64(mathhelper.AbsInt64(mon))
+	case d != 0:
+		return str + strconvhelper.FormatInt64(mathhelper.AbsInt64(d)) + " " + formatTime()
+	default:
+		return str + formatTime()
+	}
+}
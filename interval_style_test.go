@@ -0,0 +1,277 @@
+package timehelper
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestFormatStyleAndParseStyle(t *testing.T) {
+	type testElement struct {
+		i     Interval
+		style Style
+		s     string
+	}
+
+	test := []testElement{
+		// 0
+		{
+			i:     Interval{-14, 3, -14706 * 1e6, MicrosecondPrecision},
+			style: StylePostgres,
+			s:     "-1 year -2 mons 3 days -04:05:06",
+		},
+
+		// 1
+		{
+			i:     Interval{-10, 3, 14706789 * 1e3, MicrosecondPrecision},
+			style: StylePostgresVerbose,
+			s:     "@ 10 mons -3 days -4 hours -5 mins -6.789 secs ago",
+		},
+
+		// 2
+		{
+			i:     Interval{14, -3, 0, MicrosecondPrecision},
+			style: StylePostgresVerbose,
+			s:     "@ 1 year 2 mons -3 days",
+		},
+
+		// 3
+		{
+			i:     Interval{0, 1, 1e6, MicrosecondPrecision},
+			style: StylePostgresVerbose,
+			s:     "@ 1 day 1 sec",
+		},
+
+		// 4
+		{
+			i:     Interval{0, 0, 0, MicrosecondPrecision},
+			style: StylePostgresVerbose,
+			s:     "@ 0",
+		},
+
+		// 5
+		{
+			i:     Interval{0, 0, -5e5, MicrosecondPrecision},
+			style: StylePostgresVerbose,
+			s:     "@ 0.5 secs ago",
+		},
+
+		// 6
+		{
+			i:     Interval{14, 0, 0, MicrosecondPrecision},
+			style: StyleSQLStandard,
+			s:     "1-2",
+		},
+
+		// 7
+		{
+			i:     Interval{-14, 0, 0, MicrosecondPrecision},
+			style: StyleSQLStandard,
+			s:     "-1-2",
+		},
+
+		// 8
+		{
+			i:     Interval{0, -3, -14706789 * 1e3, MicrosecondPrecision},
+			style: StyleSQLStandard,
+			s:     "-3 4:05:06.789",
+		},
+
+		// 9
+		{
+			i:     Interval{14, 3, 14706 * 1e6, MicrosecondPrecision},
+			style: StyleSQLStandard,
+			s:     "+1-2 +3 +4:05:06",
+		},
+
+		// 10
+		{
+			i:     Interval{-14, 3, -14706 * 1e6, MicrosecondPrecision},
+			style: StyleSQLStandard,
+			s:     "-1-2 +3 -4:05:06",
+		},
+
+		// 11
+		{
+			i:     Interval{0, 0, 0, MicrosecondPrecision},
+			style: StyleSQLStandard,
+			s:     "0",
+		},
+
+		// 12
+		{
+			i:     Interval{0, 0, 125838 * 1e6, MicrosecondPrecision},
+			style: StyleSQLStandard,
+			s:     "34:57:18",
+		},
+
+		// 13
+		{
+			i:     Interval{-14, 3, -14706 * 1e6, MicrosecondPrecision},
+			style: StyleISO8601,
+			s:     "P-1Y-2M3DT-4H-5M-6S",
+		},
+	}
+
+	for j, v := range test {
+		s := v.i.FormatStyle(v.style)
+		if s != v.s {
+			t.Errorf("Test-%v. Strings not equal.\nExpected:\n%s\ngot:\n%s", j, v.s, s)
+		}
+		i, err := ParseStyle(v.s, v.style, MicrosecondPrecision)
+		if err != nil || i != v.i {
+			t.Errorf("Test-%v. Intervals not equal.\nExpected:\n%#v\ngot:\n%#v (error: %v)", j, v.i, i, err)
+		}
+		if style := DetectStyle(v.s); style != v.style && v.s != "0" && v.style != StylePostgres {
+			t.Errorf("Test-%v. Wrong detected style. Expected: %v, got: %v", j, v.style, style)
+		}
+	}
+}
+
+func TestParseStyle(t *testing.T) {
+	type testElement struct {
+		s     string
+		style Style
+		i     Interval
+		err   bool
+	}
+
+	test := []testElement{
+		// 0
+		{
+			s:     "2 years 1 mon 1 day",
+			style: StylePostgres,
+			i:     Interval{25, 1, 0, MicrosecondPrecision},
+		},
+
+		// 1
+		{
+			s:     "@ 1 year 2 mons -3 days ago",
+			style: StylePostgresVerbose,
+			i:     Interval{-14, 3, 0, MicrosecondPrecision},
+		},
+
+		// 2
+		{
+			s:     "-1 2:03:04",
+			style: StyleSQLStandard,
+			i:     Interval{0, -1, -7384 * 1e6, MicrosecondPrecision},
+		},
+
+		// 3
+		{
+			s:     "5",
+			style: StyleSQLStandard,
+			i:     Interval{0, 0, 5e6, MicrosecondPrecision},
+		},
+
+		// 4
+		{
+			s:     "@",
+			style: StylePostgresVerbose,
+			err:   true,
+		},
+
+		// 5
+		{
+			s:     "1 year",
+			style: StyleSQLStandard,
+			err:   true,
+		},
+
+		// 6
+		{
+			s:     "",
+			style: StyleSQLStandard,
+			err:   true,
+		},
+
+		// 7
+		{
+			s:     "1-2 3",
+			style: StyleSQLStandard,
+			i:     Interval{14, 0, 3e6, MicrosecondPrecision},
+		},
+
+		// 8
+		{
+			s:     "1 year",
+			style: Style(100),
+			err:   true,
+		},
+
+		// 9
+		{
+			s:     "@ 178956970 years 8 mons ago",
+			style: StylePostgresVerbose,
+			i:     Interval{math.MinInt32, 0, 0, MicrosecondPrecision},
+		},
+
+		// 10
+		{
+			s:     "@ 200000000 years",
+			style: StylePostgresVerbose,
+			err:   true,
+		},
+
+		// 11
+		{
+			s:     "@ 2562047789 hours",
+			style: StylePostgresVerbose,
+			err:   true,
+		},
+
+		// 12
+		{
+			s:     "-178956970-8",
+			style: StyleSQLStandard,
+			i:     Interval{math.MinInt32, 0, 0, MicrosecondPrecision},
+		},
+
+		// 13
+		{
+			s:     "200000000-0",
+			style: StyleSQLStandard,
+			err:   true,
+		},
+
+		// 14
+		{
+			s:     "3000000000 1:00:00",
+			style: StyleSQLStandard,
+			err:   true,
+		},
+
+		// 15
+		{
+			s:     "2562047789:00:00",
+			style: StyleSQLStandard,
+			err:   true,
+		},
+
+		// 16
+		{
+			s:     "9999999999999",
+			style: StyleSQLStandard,
+			err:   true,
+		},
+	}
+
+	for j, v := range test {
+		i, err := ParseStyle(v.s, v.style, MicrosecondPrecision)
+		if (err != nil) != v.err {
+			t.Errorf("Test-%v, got error: %s", j, err)
+		}
+		if !v.err && err == nil && i != v.i {
+			t.Errorf("Test-%v. Intervals not equal.\nExpected:\n%#v\ngot:\n%#v", j, v.i, i)
+		}
+	}
+
+	// Style is detected by UnmarshalText, so overflow should be reported for each style.
+	for j, s := range []string{"200000000 years", "@ 200000000 years", "200000000-0", "P200000000Y"} {
+		var i Interval
+		if err := i.UnmarshalText([]byte(s)); !errors.Is(err, ErrOverflow) {
+			t.Errorf("Test-%v. Expected overflow for %v, got: %v (%v)", j, s, err, i)
+		}
+	}
+}
@@ -428,6 +428,30 @@ func TestDuration(t *testing.T) {
 			1400,
 			0,
 		},
+
+		// 6
+		{
+			Interval{0, 1, 1500, MillisecondPrecision},
+			30,
+			1440,
+			86401500 * time.Millisecond,
+		},
+
+		// 7
+		{
+			Interval{1, -1, -2, SecondPrecision},
+			30,
+			1440,
+			(29*SecsInDay - 2) * time.Second,
+		},
+
+		// 8
+		{
+			Interval{0, 0, 1500, PicosecondPrecision},
+			30,
+			1440,
+			2 * time.Nanosecond,
+		},
 	}
 
 	for j, v := range test {
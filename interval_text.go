@@ -0,0 +1,39 @@
+package timehelper
+
+import "strings"
+
+// DefaultTextPrecision is a precision used by UnmarshalText (and UnmarshalJSON for strings) if Interval has zero precision (for example, it is Interval{}).
+// It is not safe to change it concurrently with unmarshalling, so it is expected to be set once at program start.
+var DefaultTextPrecision uint8 = defaultPrecision
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// Interval is marshalled in the same format as String returns.
+func (i Interval) MarshalText() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Text may be in any PostgreSQL IntervalStyle (see Style) or in Go duration syntax (see ParseGoDuration), format is detected automatically.
+// Precision of Interval is kept, if it is zero when DefaultTextPrecision is used.
+// On error Interval is not changed.
+func (i *Interval) UnmarshalText(text []byte) error {
+	p := i.precision
+	if p == 0 {
+		p = DefaultTextPrecision
+	}
+	r, err := parseDetect(string(text), p)
+	if err != nil {
+		return err
+	}
+	*i = r
+	return nil
+}
+
+// parseDetect parses interval in any PostgreSQL IntervalStyle or Go duration syntax.
+func parseDetect(s string, p uint8) (Interval, error) {
+	style := DetectStyle(s)
+	if style == StylePostgres && s != "" && !strings.ContainsAny(s, " :") {
+		return ParseGoDuration(s, p)
+	}
+	return ParseStyle(s, style, p)
+}
@@ -0,0 +1,131 @@
+package timehelper
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+)
+
+func TestInterval_UnmarshalText(t *testing.T) {
+	type testElement struct {
+		s   string
+		p   uint8 // precision of Interval before unmarshalling
+		i   Interval
+		err bool
+	}
+
+	test := []testElement{
+		// 0
+		{
+			s: "1 year 2 mons 3 days 04:05:06.789",
+			i: Interval{14, 3, 14706789 * 1e6, NanosecondPrecision},
+		},
+
+		// 1
+		{
+			s: "P1Y2M3DT4H5M6.789S",
+			p: MillisecondPrecision,
+			i: Interval{14, 3, 14706789, MillisecondPrecision},
+		},
+
+		// 2
+		{
+			s: "@ 1 year 2 mons -3 days ago",
+			p: MicrosecondPrecision,
+			i: Interval{-14, 3, 0, MicrosecondPrecision},
+		},
+
+		// 3
+		{
+			s: "+1-2 -3 +4:05:06",
+			p: MicrosecondPrecision,
+			i: Interval{14, -3, 14706 * 1e6, MicrosecondPrecision},
+		},
+
+		// 4
+		{
+			s: "1h30m",
+			i: Interval{0, 0, 5400 * NanosecsInSec, NanosecondPrecision},
+		},
+
+		// 5
+		{
+			s: "2mo-3d",
+			p: MicrosecondPrecision,
+			i: Interval{2, -3, 0, MicrosecondPrecision},
+		},
+
+		// 6
+		{
+			s:   "1 hour",
+			err: true,
+		},
+
+		// 7
+		{
+			s:   "1x",
+			err: true,
+		},
+	}
+
+	for j, v := range test {
+		i := NewInterval(v.p)
+		i.Days = 100
+		err := i.UnmarshalText([]byte(v.s))
+		if v.err {
+			if err == nil || i.Days != 100 {
+				t.Errorf("Test-%v. Expected error and unchanged interval, got: %v (error: %v)", j, i, err)
+			}
+			continue
+		}
+		if err != nil || i != v.i {
+			t.Errorf("Test-%v. Expected: %v, got: %v (error: %v)", j, v.i, i, err)
+		}
+	}
+}
+
+func TestInterval_MarshalText(t *testing.T) {
+	test := []Interval{
+		{14, 3, 14706789, MillisecondPrecision},
+		{-14, 3, -1, NanosecondPrecision},
+		{0, 0, 0, MicrosecondPrecision},
+	}
+
+	for j, v := range test {
+		text, err := v.MarshalText()
+		if err != nil || string(text) != v.String() {
+			t.Errorf("Test-%v. Expected: %v, got: %s (error: %v)", j, v.String(), text, err)
+			continue
+		}
+		i := NewInterval(v.Precision())
+		if err = i.UnmarshalText(text); err != nil || i != v {
+			t.Errorf("Test-%v. Round trip expected: %v, got: %v (error: %v)", j, v, i, err)
+		}
+	}
+}
+
+func TestIntervalTextEncoders(t *testing.T) {
+	type xmlElement struct {
+		I Interval `xml:"i,attr"`
+	}
+
+	x := xmlElement{Interval{0, 1, 5400, SecondPrecision}}
+	data, err := xml.Marshal(x)
+	if err != nil || string(data) != `<xmlElement i="1 days 01:30:00"></xmlElement>` {
+		t.Errorf("Unexpected XML: %s (error: %v)", data, err)
+	}
+	var x2 xmlElement
+	if err = xml.Unmarshal(data, &x2); err != nil || x2.I != x.I.SetPrecision(DefaultTextPrecision) {
+		t.Errorf("XML expected: %v, got: %v (error: %v)", x.I, x2.I, err)
+	}
+
+	m := map[Interval]int{Interval{1, 0, 0, SecondPrecision}: 1}
+	data, err = json.Marshal(m)
+	if err != nil || string(data) != `{"1 mons":1}` {
+		t.Errorf("Unexpected JSON: %s (error: %v)", data, err)
+	}
+	var m2 map[Interval]int
+	if err = json.Unmarshal(data, &m2); err != nil || m2[Interval{1, 0, 0, DefaultTextPrecision}] != 1 {
+		t.Errorf("JSON expected: %v, got: %v (error: %v)", m, m2, err)
+	}
+}
@@ -0,0 +1,166 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: pbinterval/interval.proto
+
+package pbinterval
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Interval is a calendar-aware time interval compatible with PostgreSQL interval type.
+// Unlike google.protobuf.Duration it keeps months and days separately from seconds,
+// because their length depends on the timestamp interval is added to.
+type Interval struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of months.
+	Months int32 `protobuf:"varint,1,opt,name=months,proto3" json:"months,omitempty"`
+	// Number of days.
+	Days int32 `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"`
+	// Number of whole seconds.
+	Seconds int64 `protobuf:"varint,3,opt,name=seconds,proto3" json:"seconds,omitempty"`
+	// Fraction of second in picoseconds. It has the same sign as seconds (if seconds is not zero) and is in range (-1e12; 1e12).
+	Picos int64 `protobuf:"varint,4,opt,name=picos,proto3" json:"picos,omitempty"`
+	// Number of digits after decimal point in seconds (0 - seconds, 6 - microseconds, 9 - nanoseconds, 12 - picoseconds).
+	Precision     uint32 `protobuf:"varint,5,opt,name=precision,proto3" json:"precision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Interval) Reset() {
+	*x = Interval{}
+	mi := &file_pbinterval_interval_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Interval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Interval) ProtoMessage() {}
+
+func (x *Interval) ProtoReflect() protoreflect.Message {
+	mi := &file_pbinterval_interval_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Interval.ProtoReflect.Descriptor instead.
+func (*Interval) Descriptor() ([]byte, []int) {
+	return file_pbinterval_interval_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Interval) GetMonths() int32 {
+	if x != nil {
+		return x.Months
+	}
+	return 0
+}
+
+func (x *Interval) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *Interval) GetSeconds() int64 {
+	if x != nil {
+		return x.Seconds
+	}
+	return 0
+}
+
+func (x *Interval) GetPicos() int64 {
+	if x != nil {
+		return x.Picos
+	}
+	return 0
+}
+
+func (x *Interval) GetPrecision() uint32 {
+	if x != nil {
+		return x.Precision
+	}
+	return 0
+}
+
+var File_pbinterval_interval_proto protoreflect.FileDescriptor
+
+const file_pbinterval_interval_proto_rawDesc = "" +
+	"\n" +
+	"\x19pbinterval/interval.proto\x12\rtimehelper.v1\"\x84\x01\n" +
+	"\bInterval\x12\x16\n" +
+	"\x06months\x18\x01 \x01(\x05R\x06months\x12\x12\n" +
+	"\x04days\x18\x02 \x01(\x05R\x04days\x12\x18\n" +
+	"\aseconds\x18\x03 \x01(\x03R\aseconds\x12\x14\n" +
+	"\x05picos\x18\x04 \x01(\x03R\x05picos\x12\x1c\n" +
+	"\tprecision\x18\x05 \x01(\rR\tprecisionB+Z)github.com/apaxa-io/timehelper/pbintervalb\x06proto3"
+
+var (
+	file_pbinterval_interval_proto_rawDescOnce sync.Once
+	file_pbinterval_interval_proto_rawDescData []byte
+)
+
+func file_pbinterval_interval_proto_rawDescGZIP() []byte {
+	file_pbinterval_interval_proto_rawDescOnce.Do(func() {
+		file_pbinterval_interval_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_pbinterval_interval_proto_rawDesc), len(file_pbinterval_interval_proto_rawDesc)))
+	})
+	return file_pbinterval_interval_proto_rawDescData
+}
+
+var file_pbinterval_interval_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_pbinterval_interval_proto_goTypes = []any{
+	(*Interval)(nil), // 0: timehelper.v1.Interval
+}
+var file_pbinterval_interval_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_pbinterval_interval_proto_init() }
+func file_pbinterval_interval_proto_init() {
+	if File_pbinterval_interval_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pbinterval_interval_proto_rawDesc), len(file_pbinterval_interval_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_pbinterval_interval_proto_goTypes,
+		DependencyIndexes: file_pbinterval_interval_proto_depIdxs,
+		MessageInfos:      file_pbinterval_interval_proto_msgTypes,
+	}.Build()
+	File_pbinterval_interval_proto = out.File
+	file_pbinterval_interval_proto_goTypes = nil
+	file_pbinterval_interval_proto_depIdxs = nil
+}
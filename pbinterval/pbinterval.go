@@ -0,0 +1,146 @@
+// Package pbinterval integrates timehelper.Interval with protocol buffers.
+//
+// It provides Interval message (timehelper.v1.Interval, see interval.proto) which keeps all fields of timehelper.Interval
+// including precision, and conversions to and from google.protobuf.Duration.
+package pbinterval
+
+//go:generate protoc -I .. --go_out=.. --go_opt=paths=source_relative ../pbinterval/interval.proto
+
+import (
+	"errors"
+	"github.com/apaxa-io/mathhelper"
+	"github.com/apaxa-io/timehelper"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"math/big"
+	"strconv"
+)
+
+// maxDurationSeconds is maximum absolute value of seconds in valid google.protobuf.Duration (about 10000 years).
+const maxDurationSeconds = 315576000000
+
+// ToProto converts timehelper.Interval to Interval message. Conversion is exact.
+func ToProto(i timehelper.Interval) *Interval {
+	p := i.Precision()
+	pow := pow10(p)
+	return &Interval{
+		Months:    i.Months,
+		Days:      i.Days,
+		Seconds:   i.SomeSeconds / pow,
+		Picos:     i.SomeSeconds % pow * pow10(timehelper.PicosecondPrecision-p),
+		Precision: uint32(p),
+	}
+}
+
+// FromProto converts Interval message to timehelper.Interval with precision from message.
+// Picoseconds which can not be stored with this precision are rounded.
+// Error returned if message is nil or invalid, timehelper.OverflowError returned if value does not fit in timehelper.Interval.
+func FromProto(m *Interval) (timehelper.Interval, error) {
+	if m == nil {
+		return timehelper.Interval{}, errors.New("Unable to convert nil Interval message")
+	}
+	if m.Precision > timehelper.PicosecondPrecision {
+		return timehelper.Interval{}, timehelper.ErrPrecision
+	}
+	if m.Picos <= -timehelper.PicosecsInSec || m.Picos >= timehelper.PicosecsInSec || (m.Seconds > 0 && m.Picos < 0) || (m.Seconds < 0 && m.Picos > 0) {
+		return timehelper.Interval{}, errors.New("Invalid picoseconds in Interval message: " + strconv.FormatInt(m.Picos, 10))
+	}
+
+	p := uint8(m.Precision)
+	s, ok := someSeconds(m.Seconds, m.Picos, timehelper.PicosecondPrecision, p)
+	if !ok {
+		return timehelper.Interval{}, &timehelper.OverflowError{Op: "FromProto"}
+	}
+	i := timehelper.NewInterval(p)
+	i.Months, i.Days, i.SomeSeconds = m.Months, m.Days, s
+	return i, nil
+}
+
+// ToDuration converts timehelper.Interval to google.protobuf.Duration.
+// Conversion is exact (except rounding of fraction to nanoseconds) and is possible only if Months and Days are zero,
+// because their length is not fixed. Use ToDurationApprox for intervals with months or days.
+// Error returned if interval has months or days or if it is out of google.protobuf.Duration range.
+func ToDuration(i timehelper.Interval) (*durationpb.Duration, error) {
+	if i.Months != 0 || i.Days != 0 {
+		return nil, errors.New("Unable to convert Interval with months or days to Duration exactly")
+	}
+	return ToDurationApprox(i, 0, 0)
+}
+
+// ToDurationApprox converts timehelper.Interval to google.protobuf.Duration assuming that month has daysInMonth days
+// and day has minutesInDay minutes (usually 30 and 1440), as timehelper.Interval.Duration does.
+// Fraction of second is rounded to nanoseconds.
+// Error returned if result is out of google.protobuf.Duration range.
+func ToDurationApprox(i timehelper.Interval, daysInMonth uint8, minutesInDay uint32) (*durationpb.Duration, error) {
+	p := i.Precision()
+	pow := pow10(p)
+
+	// Fraction in nanoseconds.
+	var nanos int64
+	if p <= timehelper.NanosecondPrecision {
+		nanos = i.SomeSeconds % pow * pow10(timehelper.NanosecondPrecision-p)
+	} else {
+		nanos = mathhelper.DivideRoundFixInt64(i.SomeSeconds%pow, pow10(p-timehelper.NanosecondPrecision))
+	}
+
+	sec := big.NewInt(int64(i.Months))
+	sec.Mul(sec, big.NewInt(int64(daysInMonth)))
+	sec.Add(sec, big.NewInt(int64(i.Days)))
+	sec.Mul(sec, big.NewInt(int64(minutesInDay)*timehelper.SecsInMin))
+	sec.Add(sec, big.NewInt(i.SomeSeconds/pow))
+	if nanos == timehelper.NanosecsInSec || nanos == -timehelper.NanosecsInSec {
+		sec.Add(sec, big.NewInt(nanos/timehelper.NanosecsInSec))
+		nanos = 0
+	}
+
+	// Nanoseconds should have the same sign as seconds.
+	switch {
+	case sec.Sign() > 0 && nanos < 0:
+		sec.Sub(sec, big.NewInt(1))
+		nanos += timehelper.NanosecsInSec
+	case sec.Sign() < 0 && nanos > 0:
+		sec.Add(sec, big.NewInt(1))
+		nanos -= timehelper.NanosecsInSec
+	}
+
+	if sec.CmpAbs(big.NewInt(maxDurationSeconds)) > 0 {
+		return nil, &timehelper.OverflowError{Op: "ToDuration"}
+	}
+	return &durationpb.Duration{Seconds: sec.Int64(), Nanos: int32(nanos)}, nil
+}
+
+// FromDuration converts google.protobuf.Duration to timehelper.Interval with precision p (Months and Days are zero).
+// If p is less than nanosecond precision fraction is rounded.
+// Error returned if d is nil or invalid, timehelper.OverflowError returned if value does not fit in timehelper.Interval.
+func FromDuration(d *durationpb.Duration, p uint8) (timehelper.Interval, error) {
+	if err := d.CheckValid(); err != nil {
+		return timehelper.Interval{}, err
+	}
+	i := timehelper.NewInterval(p)
+	s, ok := someSeconds(d.Seconds, int64(d.Nanos), timehelper.NanosecondPrecision, i.Precision())
+	if !ok {
+		return timehelper.Interval{}, &timehelper.OverflowError{Op: "FromDuration"}
+	}
+	i.SomeSeconds = s
+	return i, nil
+}
+
+// someSeconds returns seconds sec with fraction frac (in units of precision fp) in units of precision p.
+// Fraction is rounded if p is less than fp. If result does not fit in int64 when ok is false.
+func someSeconds(sec, frac int64, fp, p uint8) (s int64, ok bool) {
+	if p < fp {
+		frac = mathhelper.DivideRoundFixInt64(frac, pow10(fp-p))
+	} else {
+		frac *= pow10(p - fp)
+	}
+	r := new(big.Int).Mul(big.NewInt(sec), big.NewInt(pow10(p)))
+	r.Add(r, big.NewInt(frac))
+	if !r.IsInt64() {
+		return 0, false
+	}
+	return r.Int64(), true
+}
+
+// pow10 returns 10^p.
+func pow10(p uint8) int64 {
+	return mathhelper.PowInt64(10, int64(p))
+}
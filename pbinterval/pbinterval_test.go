@@ -0,0 +1,300 @@
+package pbinterval
+
+import (
+	"errors"
+	"github.com/apaxa-io/timehelper"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"math"
+	"testing"
+)
+
+func newInterval(months, days int32, someSeconds int64, p uint8) timehelper.Interval {
+	i := timehelper.NewInterval(p)
+	i.Months, i.Days, i.SomeSeconds = months, days, someSeconds
+	return i
+}
+
+func TestToProto(t *testing.T) {
+	type testElement struct {
+		i timehelper.Interval
+		m *Interval
+	}
+
+	test := []testElement{
+		// 0
+		{
+			i: newInterval(14, 3, 14706789, timehelper.MillisecondPrecision),
+			m: &Interval{Months: 14, Days: 3, Seconds: 14706, Picos: 789e9, Precision: 3},
+		},
+
+		// 1
+		{
+			i: newInterval(-1, 2, -1500, timehelper.NanosecondPrecision),
+			m: &Interval{Months: -1, Days: 2, Seconds: 0, Picos: -1500e3, Precision: 9},
+		},
+
+		// 2
+		{
+			i: newInterval(0, 0, math.MinInt64, timehelper.PicosecondPrecision),
+			m: &Interval{Seconds: -9223372, Picos: -36854775808, Precision: 12},
+		},
+
+		// 3
+		{
+			i: newInterval(math.MaxInt32, math.MinInt32, math.MaxInt64, timehelper.SecondPrecision),
+			m: &Interval{Months: math.MaxInt32, Days: math.MinInt32, Seconds: math.MaxInt64},
+		},
+	}
+
+	for j, v := range test {
+		m := ToProto(v.i)
+		if !proto.Equal(m, v.m) {
+			t.Errorf("Test-%v. Expected: %v, got: %v", j, v.m, m)
+			continue
+		}
+
+		data, err := proto.Marshal(m)
+		if err != nil {
+			t.Errorf("Test-%v. Unexpected error: %v", j, err)
+			continue
+		}
+		var m2 Interval
+		if err = proto.Unmarshal(data, &m2); err != nil {
+			t.Errorf("Test-%v. Unexpected error: %v", j, err)
+			continue
+		}
+		if i, err := FromProto(&m2); err != nil || i != v.i {
+			t.Errorf("Test-%v. Expected: %v, got: %v (error: %v)", j, v.i, i, err)
+		}
+	}
+}
+
+func TestFromProto(t *testing.T) {
+	type testElement struct {
+		m   *Interval
+		i   timehelper.Interval
+		err bool
+	}
+
+	test := []testElement{
+		// 0
+		{
+			m: &Interval{Seconds: 1, Picos: 500e9, Precision: 0},
+			i: newInterval(0, 0, 2, timehelper.SecondPrecision),
+		},
+
+		// 1
+		{
+			m: &Interval{Seconds: -1, Picos: -1234567, Precision: 6},
+			i: newInterval(0, 0, -1000001, timehelper.MicrosecondPrecision),
+		},
+
+		// 2
+		{
+			m:   nil,
+			err: true,
+		},
+
+		// 3
+		{
+			m:   &Interval{Precision: 13},
+			err: true,
+		},
+
+		// 4
+		{
+			m:   &Interval{Seconds: 1, Picos: -1},
+			err: true,
+		},
+
+		// 5
+		{
+			m:   &Interval{Picos: 1e12},
+			err: true,
+		},
+
+		// 6
+		{
+			m:   &Interval{Seconds: math.MaxInt64, Precision: 1},
+			err: true,
+		},
+	}
+
+	for j, v := range test {
+		i, err := FromProto(v.m)
+		if (err != nil) != v.err {
+			t.Errorf("Test-%v. Unexpected error: %v", j, err)
+		} else if !v.err && i != v.i {
+			t.Errorf("Test-%v. Expected: %v, got: %v", j, v.i, i)
+		}
+	}
+
+	var e *timehelper.OverflowError
+	if _, err := FromProto(&Interval{Seconds: math.MaxInt64, Precision: 1}); !errors.As(err, &e) {
+		t.Errorf("Expected overflow error, got: %v", err)
+	}
+}
+
+func TestToDuration(t *testing.T) {
+	type testElement struct {
+		i   timehelper.Interval
+		d   *durationpb.Duration
+		err bool
+	}
+
+	test := []testElement{
+		// 0
+		{
+			i: newInterval(0, 0, 14706789, timehelper.MillisecondPrecision),
+			d: &durationpb.Duration{Seconds: 14706, Nanos: 789e6},
+		},
+
+		// 1
+		{
+			i: newInterval(0, 0, -1999999999999, timehelper.PicosecondPrecision),
+			d: &durationpb.Duration{Seconds: -2},
+		},
+
+		// 2
+		{
+			i: newInterval(0, 0, 1500, timehelper.PicosecondPrecision),
+			d: &durationpb.Duration{Nanos: 2},
+		},
+
+		// 3
+		{
+			i:   newInterval(1, 0, 0, timehelper.SecondPrecision),
+			err: true,
+		},
+
+		// 4
+		{
+			i:   newInterval(0, -1, 0, timehelper.SecondPrecision),
+			err: true,
+		},
+
+		// 5
+		{
+			i:   newInterval(0, 0, math.MaxInt64, timehelper.SecondPrecision),
+			err: true,
+		},
+	}
+
+	for j, v := range test {
+		d, err := ToDuration(v.i)
+		if (err != nil) != v.err {
+			t.Errorf("Test-%v. Unexpected error: %v", j, err)
+		} else if !v.err && !proto.Equal(d, v.d) {
+			t.Errorf("Test-%v. Expected: %v, got: %v", j, v.d, d)
+		}
+	}
+}
+
+func TestToDurationApprox(t *testing.T) {
+	type testElement struct {
+		i   timehelper.Interval
+		d   *durationpb.Duration
+		err bool
+	}
+
+	test := []testElement{
+		// 0
+		{
+			i: newInterval(1, 2, 3*timehelper.NanosecsInSec, timehelper.NanosecondPrecision),
+			d: &durationpb.Duration{Seconds: 32*timehelper.SecsInDay + 3},
+		},
+
+		// 1
+		{
+			i: newInterval(0, 1, -500, timehelper.MillisecondPrecision),
+			d: &durationpb.Duration{Seconds: timehelper.SecsInDay - 1, Nanos: 500e6},
+		},
+
+		// 2
+		{
+			i: newInterval(0, -1, 500, timehelper.MillisecondPrecision),
+			d: &durationpb.Duration{Seconds: -timehelper.SecsInDay + 1, Nanos: -500e6},
+		},
+
+		// 3
+		{
+			i:   newInterval(math.MaxInt32, 0, 0, timehelper.MillisecondPrecision),
+			err: true,
+		},
+	}
+
+	for j, v := range test {
+		d, err := ToDurationApprox(v.i, 30, 1440)
+		if (err != nil) != v.err {
+			t.Errorf("Test-%v. Unexpected error: %v", j, err)
+		} else if !v.err && !proto.Equal(d, v.d) {
+			t.Errorf("Test-%v. Expected: %v, got: %v", j, v.d, d)
+		}
+	}
+}
+
+func TestFromDuration(t *testing.T) {
+	type testElement struct {
+		d   *durationpb.Duration
+		p   uint8
+		i   timehelper.Interval
+		err bool
+	}
+
+	test := []testElement{
+		// 0
+		{
+			d: &durationpb.Duration{Seconds: 14706, Nanos: 789e6},
+			p: timehelper.MillisecondPrecision,
+			i: newInterval(0, 0, 14706789, timehelper.MillisecondPrecision),
+		},
+
+		// 1
+		{
+			d: &durationpb.Duration{Seconds: -1, Nanos: -500e6},
+			p: timehelper.SecondPrecision,
+			i: newInterval(0, 0, -2, timehelper.SecondPrecision),
+		},
+
+		// 2
+		{
+			d:   &durationpb.Duration{Seconds: 315576000000, Nanos: 1},
+			p:   timehelper.PicosecondPrecision,
+			err: true,
+		},
+
+		// 3
+		{
+			d:   &durationpb.Duration{Seconds: 1, Nanos: -1},
+			p:   timehelper.NanosecondPrecision,
+			err: true,
+		},
+
+		// 4
+		{
+			d:   nil,
+			err: true,
+		},
+	}
+
+	for j, v := range test {
+		i, err := FromDuration(v.d, v.p)
+		if (err != nil) != v.err {
+			t.Errorf("Test-%v. Unexpected error: %v", j, err)
+		} else if !v.err && i != v.i {
+			t.Errorf("Test-%v. Expected: %v, got: %v", j, v.i, i)
+		}
+	}
+
+	for j, v := range []timehelper.Interval{newInterval(0, 0, -1234567891, timehelper.NanosecondPrecision), newInterval(0, 0, 12, timehelper.SecondPrecision)} {
+		d, err := ToDuration(v)
+		if err != nil {
+			t.Errorf("Round trip test-%v. Unexpected error: %v", j, err)
+			continue
+		}
+		if i, err := FromDuration(d, v.Precision()); err != nil || i != v {
+			t.Errorf("Round trip test-%v. Expected: %v, got: %v (error: %v)", j, v, i, err)
+		}
+	}
+}
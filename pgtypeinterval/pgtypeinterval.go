@@ -0,0 +1,158 @@
+// Package pgtypeinterval integrates timehelper.Interval and timehelper.NullInterval with pgx v5.
+//
+// After Register (which installs Codec) is called for pgtype.Map (for example from pgx.ConnConfig.AfterConnect: Register(conn.TypeMap()))
+// timehelper.Interval, timehelper.NullInterval and slices of them can be used directly as query arguments and scan targets
+// for PostgreSQL interval and interval[] in both binary and text formats.
+// Nothing is changed globally.
+package pgtypeinterval
+
+import (
+	"errors"
+	"github.com/apaxa-io/timehelper"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Interval is a timehelper.Interval which implements pgtype.IntervalScanner and pgtype.IntervalValuer interfaces.
+type Interval timehelper.Interval
+
+// ScanInterval implements the pgtype.IntervalScanner interface.
+// Scanned Interval has PostgreSQL precision. NULL can not be scanned to Interval, use NullInterval for nullable columns.
+func (i *Interval) ScanInterval(v pgtype.Interval) error {
+	if !v.Valid {
+		return errors.New("Interval.ScanInterval cannot scan NULL value, use NullInterval instead")
+	}
+	r := timehelper.NewPgInterval()
+	r.Months, r.Days, r.SomeSeconds = v.Months, v.Days, v.Microseconds
+	*i = Interval(r)
+	return nil
+}
+
+// IntervalValue implements the pgtype.IntervalValuer interface.
+// Seconds part is converted to PostgreSQL precision (microseconds), timehelper.OverflowError returned if it does not fit.
+func (i Interval) IntervalValue() (pgtype.Interval, error) {
+	r, err := timehelper.Interval(i).SetPrecisionChecked(timehelper.PostgreSQLPrecision)
+	if err != nil {
+		return pgtype.Interval{}, err
+	}
+	return pgtype.Interval{Microseconds: r.SomeSeconds, Days: r.Days, Months: r.Months, Valid: true}, nil
+}
+
+// NullInterval is a timehelper.NullInterval which implements pgtype.IntervalScanner and pgtype.IntervalValuer interfaces.
+type NullInterval timehelper.NullInterval
+
+// ScanInterval implements the pgtype.IntervalScanner interface.
+func (n *NullInterval) ScanInterval(v pgtype.Interval) error {
+	if !v.Valid {
+		n.Interval, n.Valid = timehelper.Interval{}, false
+		return nil
+	}
+	n.Valid = true
+	return (*Interval)(&n.Interval).ScanInterval(v)
+}
+
+// IntervalValue implements the pgtype.IntervalValuer interface.
+func (n NullInterval) IntervalValue() (pgtype.Interval, error) {
+	if !n.Valid {
+		return pgtype.Interval{}, nil
+	}
+	return Interval(n.Interval).IntervalValue()
+}
+
+// Codec is a pgtype.IntervalCodec which additionally supports timehelper.Interval and timehelper.NullInterval.
+// Values of this types are converted to Interval and NullInterval and handled by pgtype.IntervalCodec.
+type Codec struct {
+	pgtype.IntervalCodec
+}
+
+// PlanEncode implements the pgtype.Codec interface.
+func (c Codec) PlanEncode(m *pgtype.Map, oid uint32, format int16, value interface{}) pgtype.EncodePlan {
+	var plan pgtype.WrappedEncodePlanNextSetter
+	switch v := value.(type) {
+	case timehelper.Interval:
+		plan, value = &wrapIntervalEncodePlan{}, Interval(v)
+	case timehelper.NullInterval:
+		plan, value = &wrapNullIntervalEncodePlan{}, NullInterval(v)
+	default:
+		return c.IntervalCodec.PlanEncode(m, oid, format, value)
+	}
+	next := c.IntervalCodec.PlanEncode(m, oid, format, value)
+	if next == nil {
+		return nil
+	}
+	plan.SetNext(next)
+	return plan
+}
+
+// PlanScan implements the pgtype.Codec interface.
+func (c Codec) PlanScan(m *pgtype.Map, oid uint32, format int16, target interface{}) pgtype.ScanPlan {
+	var plan pgtype.WrappedScanPlanNextSetter
+	switch v := target.(type) {
+	case *timehelper.Interval:
+		plan, target = &wrapIntervalScanPlan{}, (*Interval)(v)
+	case *timehelper.NullInterval:
+		plan, target = &wrapNullIntervalScanPlan{}, (*NullInterval)(v)
+	default:
+		return c.IntervalCodec.PlanScan(m, oid, format, target)
+	}
+	next := c.IntervalCodec.PlanScan(m, oid, format, target)
+	if next == nil {
+		return nil
+	}
+	plan.SetNext(next)
+	return plan
+}
+
+// Register registers Codec for PostgreSQL interval (and relative array codec for interval[]) in m.
+// Additionally timehelper.Interval, timehelper.NullInterval and slices of them are registered as Go types for PostgreSQL interval and interval[],
+// so pgx chooses right type for arguments without known parameter type (for example in pgx.QueryExecModeExec).
+// Only m is changed.
+func Register(m *pgtype.Map) {
+	t := &pgtype.Type{Name: "interval", OID: pgtype.IntervalOID, Codec: Codec{}}
+	m.RegisterType(t)
+	m.RegisterType(&pgtype.Type{Name: "_interval", OID: pgtype.IntervalArrayOID, Codec: &pgtype.ArrayCodec{ElementType: t}})
+
+	m.RegisterDefaultPgType(timehelper.Interval{}, "interval")
+	m.RegisterDefaultPgType(timehelper.NullInterval{}, "interval")
+	m.RegisterDefaultPgType([]timehelper.Interval{}, "_interval")
+	m.RegisterDefaultPgType([]timehelper.NullInterval{}, "_interval")
+}
+
+type wrapIntervalEncodePlan struct {
+	next pgtype.EncodePlan
+}
+
+func (plan *wrapIntervalEncodePlan) SetNext(next pgtype.EncodePlan) { plan.next = next }
+
+func (plan *wrapIntervalEncodePlan) Encode(value interface{}, buf []byte) ([]byte, error) {
+	return plan.next.Encode(Interval(value.(timehelper.Interval)), buf)
+}
+
+type wrapNullIntervalEncodePlan struct {
+	next pgtype.EncodePlan
+}
+
+func (plan *wrapNullIntervalEncodePlan) SetNext(next pgtype.EncodePlan) { plan.next = next }
+
+func (plan *wrapNullIntervalEncodePlan) Encode(value interface{}, buf []byte) ([]byte, error) {
+	return plan.next.Encode(NullInterval(value.(timehelper.NullInterval)), buf)
+}
+
+type wrapIntervalScanPlan struct {
+	next pgtype.ScanPlan
+}
+
+func (plan *wrapIntervalScanPlan) SetNext(next pgtype.ScanPlan) { plan.next = next }
+
+func (plan *wrapIntervalScanPlan) Scan(src []byte, dst interface{}) error {
+	return plan.next.Scan(src, (*Interval)(dst.(*timehelper.Interval)))
+}
+
+type wrapNullIntervalScanPlan struct {
+	next pgtype.ScanPlan
+}
+
+func (plan *wrapNullIntervalScanPlan) SetNext(next pgtype.ScanPlan) { plan.next = next }
+
+func (plan *wrapNullIntervalScanPlan) Scan(src []byte, dst interface{}) error {
+	return plan.next.Scan(src, (*NullInterval)(dst.(*timehelper.NullInterval)))
+}
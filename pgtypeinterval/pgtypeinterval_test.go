@@ -0,0 +1,124 @@
+package pgtypeinterval
+
+import (
+	"github.com/apaxa-io/timehelper"
+	"github.com/jackc/pgx/v5/pgtype"
+	"testing"
+)
+
+func TestEncodeAndScan(t *testing.T) {
+	type testElement struct {
+		i   timehelper.Interval
+		res timehelper.Interval
+		err bool
+	}
+
+	test := []testElement{
+		// 0
+		{
+			i:   timehelper.Day().Add(timehelper.Millisecond()).SetPrecision(timehelper.PostgreSQLPrecision),
+			res: timehelper.Day().Add(timehelper.Millisecond()).SetPrecision(timehelper.PostgreSQLPrecision),
+		},
+
+		// 1
+		{
+			i:   timehelper.Year().Mul(-1).Add(timehelper.Nanosecond().Mul(1500)),
+			res: timehelper.Year().Mul(-1).Add(timehelper.Microsecond().Mul(2)).SetPrecision(timehelper.PostgreSQLPrecision),
+		},
+
+		// 2
+		{
+			i:   timehelper.NewGoInterval(),
+			res: timehelper.NewPgInterval(),
+		},
+
+		// 3
+		{
+			i:   timehelper.Interval{SomeSeconds: 1 << 62},
+			err: true,
+		},
+	}
+
+	m := pgtype.NewMap()
+	Register(m)
+	for j, v := range test {
+		for _, format := range []int16{pgtype.BinaryFormatCode, pgtype.TextFormatCode} {
+			buf, err := m.Encode(pgtype.IntervalOID, format, v.i, nil)
+			if (err != nil) != v.err {
+				t.Errorf("Test-%v-%v. Unexpected error: %v", j, format, err)
+				continue
+			}
+			if v.err {
+				continue
+			}
+
+			var i timehelper.Interval
+			if err = m.Scan(pgtype.IntervalOID, format, buf, &i); err != nil {
+				t.Errorf("Test-%v-%v. Unexpected error: %v", j, format, err)
+			} else if i != v.res {
+				t.Errorf("Test-%v-%v. Expected: %v, got: %v", j, format, v.res, i)
+			}
+		}
+	}
+}
+
+func TestArray(t *testing.T) {
+	src := []timehelper.Interval{timehelper.Day(), timehelper.Hour().SetPrecision(timehelper.PostgreSQLPrecision), timehelper.Month()}
+
+	m := pgtype.NewMap()
+	Register(m)
+	typ, ok := m.TypeForValue(src)
+	if !ok || typ.OID != pgtype.IntervalArrayOID {
+		t.Fatalf("Expected interval[] type for []Interval, got: %v", typ)
+	}
+
+	for _, format := range []int16{pgtype.BinaryFormatCode, pgtype.TextFormatCode} {
+		buf, err := m.Encode(pgtype.IntervalArrayOID, format, src, nil)
+		if err != nil {
+			t.Errorf("Test-%v. Unexpected error: %v", format, err)
+			continue
+		}
+
+		var dst []timehelper.Interval
+		if err = m.Scan(pgtype.IntervalArrayOID, format, buf, &dst); err != nil {
+			t.Errorf("Test-%v. Unexpected error: %v", format, err)
+			continue
+		}
+		if len(dst) != len(src) {
+			t.Errorf("Test-%v. Expected: %v, got: %v", format, src, dst)
+			continue
+		}
+		for j := range src {
+			if !dst[j].Equal(src[j]) || dst[j].Precision() != timehelper.PostgreSQLPrecision {
+				t.Errorf("Test-%v-%v. Expected: %v, got: %v", format, j, src[j], dst[j])
+			}
+		}
+	}
+}
+
+func TestNull(t *testing.T) {
+	m := pgtype.NewMap()
+	Register(m)
+
+	var i timehelper.Interval
+	if err := m.Scan(pgtype.IntervalOID, pgtype.BinaryFormatCode, nil, &i); err == nil {
+		t.Errorf("Expected error while scanning NULL into Interval")
+	}
+
+	n := timehelper.NullInterval{Interval: timehelper.Day(), Valid: true}
+	if err := m.Scan(pgtype.IntervalOID, pgtype.BinaryFormatCode, nil, &n); err != nil || n.Valid {
+		t.Errorf("Expected NULL interval, got: %v (error: %v)", n, err)
+	}
+
+	buf, err := m.Encode(pgtype.IntervalOID, pgtype.BinaryFormatCode, timehelper.NullInterval{}, nil)
+	if err != nil || buf != nil {
+		t.Errorf("Expected NULL, got: %v (error: %v)", buf, err)
+	}
+
+	n = timehelper.NullInterval{Interval: timehelper.Day(), Valid: true}
+	if buf, err = m.Encode(pgtype.IntervalOID, pgtype.BinaryFormatCode, n, nil); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	} else if err = m.Scan(pgtype.IntervalOID, pgtype.BinaryFormatCode, buf, &n); err != nil || !n.Valid || !n.Interval.Equal(timehelper.Day()) {
+		t.Errorf("Expected 1 day, got: %v (error: %v)", n, err)
+	}
+}
@@ -0,0 +1,80 @@
+// Package pgxinterval integrates timehelper.Interval with legacy pgx (v2) ValueReader/WriteBuf API.
+//
+// Nothing is changed in pgx until Register is called.
+// To scan interval convert pointer to timehelper.Interval: rows.Scan((*pgxinterval.Interval)(&i)).
+// To pass interval as query argument convert it: conn.Exec(sql, pgxinterval.Interval(i)).
+package pgxinterval
+
+import (
+	"fmt"
+	"github.com/apaxa-io/timehelper"
+	"github.com/jackc/pgx"
+)
+
+// IntervalOid is OID of PostgreSQL interval type.
+const IntervalOid = 1186
+
+// Register registers interval type in pgx as binary-compatible.
+// It changes global pgx.DefaultTypeFormats, so it affects all connections created after the call.
+// This may cause error if type other when Interval will be used with pgx for interval storing.
+func Register() {
+	pgx.DefaultTypeFormats["interval"] = pgx.BinaryFormatCode
+}
+
+// Interval is a timehelper.Interval which implements pgx.Scanner and pgx.Encoder interfaces.
+type Interval timehelper.Interval
+
+// Scan implements the pgx.Scanner interface.
+func (u *Interval) Scan(vr *pgx.ValueReader) error {
+	if vr.Type().DataType != IntervalOid {
+		return pgx.SerializationError(fmt.Sprintf("Interval.Scan cannot decode %s (OID %d)", vr.Type().DataTypeName, vr.Type().DataType))
+	}
+
+	if vr.Len() == -1 {
+		return pgx.SerializationError("Interval.Scan cannot parse NULL value")
+	}
+
+	var i timehelper.Interval
+	var err error
+	switch vr.Type().FormatCode {
+	case pgx.TextFormatCode:
+		s := vr.ReadString(vr.Len())
+		if i, err = timehelper.ParseStyle(s, timehelper.DetectStyle(s), timehelper.PostgreSQLPrecision); err != nil {
+			return pgx.SerializationError(fmt.Sprintf("Received invalid Interval string: %v", err.Error()))
+		}
+	case pgx.BinaryFormatCode:
+		if vr.Len() != timehelper.PostgreSQLBinaryLen {
+			return pgx.SerializationError(fmt.Sprintf("Received Interval with invalid length: %d", vr.Len()))
+		}
+		if i, err = timehelper.ParsePostgreSQLBinary(vr.ReadBytes(vr.Len())); err != nil {
+			return pgx.SerializationError(fmt.Sprintf("Received invalid Interval: %v", err.Error()))
+		}
+	default:
+		return fmt.Errorf("unknown format %v", vr.Type().FormatCode)
+	}
+
+	*u = Interval(i)
+	return vr.Err()
+}
+
+// FormatCode implements the pgx.Encoder interface.
+func (u Interval) FormatCode() int16 {
+	return pgx.BinaryFormatCode
+}
+
+// Encode implements the pgx.Encoder interface.
+func (u Interval) Encode(w *pgx.WriteBuf, oid pgx.Oid) error {
+	if oid != IntervalOid {
+		return pgx.SerializationError(fmt.Sprintf("Interval.Encode cannot encode into OID %d", oid))
+	}
+
+	b, err := timehelper.Interval(u).AppendPostgreSQLBinary(make([]byte, 0, timehelper.PostgreSQLBinaryLen))
+	if err != nil {
+		return pgx.SerializationError(fmt.Sprintf("Interval.Encode cannot encode: %v", err.Error()))
+	}
+
+	w.WriteInt32(timehelper.PostgreSQLBinaryLen)
+	w.WriteBytes(b)
+
+	return nil
+}
@@ -0,0 +1,84 @@
+// Package yamlinterval integrates timehelper.Interval with gopkg.in/yaml.v3.
+//
+// timehelper.Interval implements encoding.TextUnmarshaler, so yaml.v3 can already decode it from scalar string.
+// Interval type of this package additionally accepts mapping form:
+// 	retention: 3 mons 2 days
+// 	retention: P3M2D
+// 	retention:
+// 	  months: 3
+// 	  days: 2
+// 	  seconds: 4.5
+package yamlinterval
+
+import (
+	"errors"
+	"github.com/apaxa-io/timehelper"
+	"gopkg.in/yaml.v3"
+	"regexp"
+	"strconv"
+)
+
+// RE for seconds in mapping form.
+var reSeconds = regexp.MustCompile(`^[+-]?(?:[0-9]+(?:\.[0-9]*)?|\.[0-9]+)$`)
+
+// Interval is a timehelper.Interval which implements yaml.Marshaler and yaml.Unmarshaler interfaces.
+type Interval timehelper.Interval
+
+// intervalYAML is a mapping form of Interval.
+type intervalYAML struct {
+	Months    int32  `yaml:"months"`
+	Days      int32  `yaml:"days"`
+	Seconds   string `yaml:"seconds"`
+	Precision *uint8 `yaml:"precision"`
+}
+
+// MarshalYAML implements the yaml.Marshaler interface.
+// Interval is marshalled as a string in the same format as timehelper.Interval.String returns.
+func (i Interval) MarshalYAML() (interface{}, error) {
+	return timehelper.Interval(i).String(), nil
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+// Scalar may be in any format accepted by timehelper.Interval.UnmarshalText (any PostgreSQL IntervalStyle or Go duration syntax),
+// precision of Interval is kept, if it is zero when timehelper.DefaultTextPrecision is used.
+// Mapping may have "months" and "days" integer keys, "seconds" decimal key (fraction is allowed)
+// and "precision" key (timehelper.DefaultTextPrecision if missing), all keys are optional.
+// On error Interval is not changed.
+func (i *Interval) UnmarshalYAML(value *yaml.Node) error {
+	switch value.Kind {
+	case yaml.ScalarNode:
+		r := timehelper.Interval(*i)
+		if err := r.UnmarshalText([]byte(value.Value)); err != nil {
+			return err
+		}
+		*i = Interval(r)
+		return nil
+	case yaml.MappingNode:
+		var v intervalYAML
+		if err := value.Decode(&v); err != nil {
+			return err
+		}
+		p := timehelper.DefaultTextPrecision
+		if v.Precision != nil {
+			if *v.Precision > timehelper.PicosecondPrecision {
+				return errors.New("Invalid interval precision " + strconv.Itoa(int(*v.Precision)))
+			}
+			p = *v.Precision
+		}
+		r := timehelper.NewInterval(p)
+		if v.Seconds != "" {
+			if !reSeconds.MatchString(v.Seconds) {
+				return errors.New("Unable to parse interval seconds " + v.Seconds)
+			}
+			var err error
+			if r, err = timehelper.ParseGoDuration(v.Seconds+"s", p); err != nil {
+				return errors.New("Unable to parse interval seconds " + v.Seconds)
+			}
+		}
+		r.Months, r.Days = v.Months, v.Days
+		*i = Interval(r)
+		return nil
+	default:
+		return errors.New("Unable to unmarshal interval from YAML node at line " + strconv.Itoa(value.Line))
+	}
+}
@@ -0,0 +1,119 @@
+package yamlinterval
+
+import (
+	"github.com/apaxa-io/timehelper"
+	"gopkg.in/yaml.v3"
+	"testing"
+)
+
+func newInterval(months, days int32, someSeconds int64, p uint8) Interval {
+	i := timehelper.NewInterval(p)
+	i.Months, i.Days, i.SomeSeconds = months, days, someSeconds
+	return Interval(i)
+}
+
+func TestInterval_UnmarshalYAML(t *testing.T) {
+	type config struct {
+		Retention Interval `yaml:"retention"`
+	}
+	type testElement struct {
+		s   string
+		i   Interval
+		err bool
+	}
+
+	test := []testElement{
+		// 0
+		{
+			s: "retention: 3 mons 2 days",
+			i: newInterval(3, 2, 0, timehelper.DefaultTextPrecision),
+		},
+
+		// 1
+		{
+			s: "retention: P3M2D",
+			i: newInterval(3, 2, 0, timehelper.DefaultTextPrecision),
+		},
+
+		// 2
+		{
+			s: "retention: -1 year 04:05:06.5",
+			i: newInterval(-12, 0, 14706500000000, timehelper.DefaultTextPrecision),
+		},
+
+		// 3
+		{
+			s: "retention: 30s",
+			i: newInterval(0, 0, 30e9, timehelper.DefaultTextPrecision),
+		},
+
+		// 4
+		{
+			s: "retention:\n  months: 3\n  days: 2\n  seconds: 4.5",
+			i: newInterval(3, 2, 4500000000, timehelper.DefaultTextPrecision),
+		},
+
+		// 5
+		{
+			s: "retention:\n  days: -1\n  seconds: -0.25\n  precision: 1",
+			i: newInterval(0, -1, -3, 1),
+		},
+
+		// 6
+		{
+			s:   "retention: 3 months",
+			err: true,
+		},
+
+		// 7
+		{
+			s:   "retention:\n  seconds: 1m",
+			err: true,
+		},
+
+		// 8
+		{
+			s:   "retention:\n  precision: 13",
+			err: true,
+		},
+
+		// 9
+		{
+			s:   "retention:\n  months: x",
+			err: true,
+		},
+
+		// 10
+		{
+			s:   "retention: [1, 2]",
+			err: true,
+		},
+	}
+
+	for j, v := range test {
+		var c config
+		err := yaml.Unmarshal([]byte(v.s), &c)
+		if (err != nil) != v.err {
+			t.Errorf("Test-%v. Unexpected error: %v", j, err)
+		} else if !v.err && c.Retention != v.i {
+			t.Errorf("Test-%v. Expected: %v, got: %v", j, timehelper.Interval(v.i), timehelper.Interval(c.Retention))
+		}
+	}
+}
+
+func TestInterval_MarshalYAML(t *testing.T) {
+	type config struct {
+		Retention Interval `yaml:"retention"`
+	}
+
+	c := config{newInterval(3, 2, 4500, timehelper.MillisecondPrecision)}
+	data, err := yaml.Marshal(c)
+	if err != nil || string(data) != "retention: 3 mons 2 days 00:00:04.5\n" {
+		t.Errorf("Unexpected YAML: %s (error: %v)", data, err)
+	}
+
+	c2 := config{Interval(timehelper.NewInterval(timehelper.MillisecondPrecision))}
+	if err = yaml.Unmarshal(data, &c2); err != nil || c2 != c {
+		t.Errorf("Expected: %v, got: %v (error: %v)", timehelper.Interval(c.Retention), timehelper.Interval(c2.Retention), err)
+	}
+}